@@ -0,0 +1,166 @@
+package gcp
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"google.golang.org/api/googleapi"
+)
+
+// The Cloud Quotas API has no discovery based client in the version of
+// google.golang.org/api used by the plugin, so the few read-only methods used
+// by the gcp_quota_* tables are called directly over an authenticated client.
+//
+// https://cloud.google.com/docs/quotas/reference/rest
+
+const cloudQuotasBasePath = "https://cloudquotas.googleapis.com/"
+
+type cloudQuotasService struct {
+	client   *http.Client
+	basePath string
+}
+
+type cloudQuotasQuotaInfo struct {
+	Name                     string                               `json:"name,omitempty"`
+	QuotaId                  string                               `json:"quotaId,omitempty"`
+	Metric                   string                               `json:"metric,omitempty"`
+	Service                  string                               `json:"service,omitempty"`
+	IsPrecise                bool                                 `json:"isPrecise,omitempty"`
+	RefreshInterval          string                               `json:"refreshInterval,omitempty"`
+	ContainerType            string                               `json:"containerType,omitempty"`
+	Dimensions               []string                             `json:"dimensions,omitempty"`
+	MetricDisplayName        string                               `json:"metricDisplayName,omitempty"`
+	QuotaDisplayName         string                               `json:"quotaDisplayName,omitempty"`
+	MetricUnit               string                               `json:"metricUnit,omitempty"`
+	QuotaIncreaseEligibility *cloudQuotasQuotaIncreaseEligibility `json:"quotaIncreaseEligibility,omitempty"`
+	IsFixed                  bool                                 `json:"isFixed,omitempty"`
+	DimensionsInfos          []*cloudQuotasDimensionsInfo         `json:"dimensionsInfos,omitempty"`
+	IsConcurrent             bool                                 `json:"isConcurrent,omitempty"`
+	ServiceRequestQuotaUri   string                               `json:"serviceRequestQuotaUri,omitempty"`
+}
+
+type cloudQuotasQuotaIncreaseEligibility struct {
+	IsEligible          bool   `json:"isEligible,omitempty"`
+	IneligibilityReason string `json:"ineligibilityReason,omitempty"`
+}
+
+type cloudQuotasDimensionsInfo struct {
+	Dimensions map[string]string `json:"dimensions,omitempty"`
+	Details    *struct {
+		Value       int64 `json:"value,omitempty,string"`
+		RolloutInfo *struct {
+			OngoingRollout bool `json:"ongoingRollout,omitempty"`
+		} `json:"rolloutInfo,omitempty"`
+	} `json:"details,omitempty"`
+	ApplicableLocations []string `json:"applicableLocations,omitempty"`
+}
+
+type cloudQuotasQuotaPreference struct {
+	Name          string                  `json:"name,omitempty"`
+	Dimensions    map[string]string       `json:"dimensions,omitempty"`
+	QuotaConfig   *cloudQuotasQuotaConfig `json:"quotaConfig,omitempty"`
+	Etag          string                  `json:"etag,omitempty"`
+	CreateTime    string                  `json:"createTime,omitempty"`
+	UpdateTime    string                  `json:"updateTime,omitempty"`
+	Service       string                  `json:"service,omitempty"`
+	QuotaId       string                  `json:"quotaId,omitempty"`
+	Reconciling   bool                    `json:"reconciling,omitempty"`
+	Justification string                  `json:"justification,omitempty"`
+	ContactEmail  string                  `json:"contactEmail,omitempty"`
+}
+
+type cloudQuotasQuotaConfig struct {
+	PreferredValue int64             `json:"preferredValue,omitempty,string"`
+	StateDetail    string            `json:"stateDetail,omitempty"`
+	GrantedValue   int64             `json:"grantedValue,omitempty,string"`
+	TraceId        string            `json:"traceId,omitempty"`
+	Annotations    map[string]string `json:"annotations,omitempty"`
+	RequestOrigin  string            `json:"requestOrigin,omitempty"`
+}
+
+type cloudQuotasListQuotaInfosResponse struct {
+	QuotaInfos    []*cloudQuotasQuotaInfo `json:"quotaInfos,omitempty"`
+	NextPageToken string                  `json:"nextPageToken,omitempty"`
+}
+
+type cloudQuotasListQuotaPreferencesResponse struct {
+	QuotaPreferences []*cloudQuotasQuotaPreference `json:"quotaPreferences,omitempty"`
+	NextPageToken    string                        `json:"nextPageToken,omitempty"`
+	Unreachable      []string                      `json:"unreachable,omitempty"`
+}
+
+// listQuotaInfos calls the ListQuotaInfos method for the given parent, e.g.
+// projects/my-project/locations/global/services/compute.googleapis.com
+func (s *cloudQuotasService) listQuotaInfos(ctx context.Context, parent string, pageSize int64, pageToken string) (*cloudQuotasListQuotaInfosResponse, error) {
+	params := url.Values{}
+	params.Set("pageSize", strconv.FormatInt(pageSize, 10))
+	if pageToken != "" {
+		params.Set("pageToken", pageToken)
+	}
+
+	resp := &cloudQuotasListQuotaInfosResponse{}
+	if err := s.get(ctx, "v1/"+parent+"/quotaInfos", params, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// getQuotaInfo calls the GetQuotaInfo method for the given quota info name
+func (s *cloudQuotasService) getQuotaInfo(ctx context.Context, name string) (*cloudQuotasQuotaInfo, error) {
+	resp := &cloudQuotasQuotaInfo{}
+	if err := s.get(ctx, "v1/"+name, url.Values{}, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// listQuotaPreferences calls the ListQuotaPreferences method for the given
+// parent, e.g. projects/my-project/locations/global
+func (s *cloudQuotasService) listQuotaPreferences(ctx context.Context, parent string, pageSize int64, pageToken string) (*cloudQuotasListQuotaPreferencesResponse, error) {
+	params := url.Values{}
+	params.Set("pageSize", strconv.FormatInt(pageSize, 10))
+	if pageToken != "" {
+		params.Set("pageToken", pageToken)
+	}
+
+	resp := &cloudQuotasListQuotaPreferencesResponse{}
+	if err := s.get(ctx, "v1/"+parent+"/quotaPreferences", params, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// getQuotaPreference calls the GetQuotaPreference method for the given quota
+// preference name
+func (s *cloudQuotasService) getQuotaPreference(ctx context.Context, name string) (*cloudQuotasQuotaPreference, error) {
+	resp := &cloudQuotasQuotaPreference{}
+	if err := s.get(ctx, "v1/"+name, url.Values{}, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// get sends a GET request to the given path and decodes the JSON response into
+// out. Errors are returned as *googleapi.Error, like the generated clients.
+func (s *cloudQuotasService) get(ctx context.Context, path string, params url.Values, out interface{}) error {
+	params.Set("alt", "json")
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.basePath+path+"?"+params.Encode(), nil)
+	if err != nil {
+		return err
+	}
+
+	res, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if err := googleapi.CheckResponse(res); err != nil {
+		return err
+	}
+
+	return json.NewDecoder(res.Body).Decode(out)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"google.golang.org/api/compute/v1"
 )
 
 // func init() {
@@ -49,3 +50,41 @@ func BuildComputeLocationList(ctx context.Context, d *plugin.QueryData) []map[st
 	d.ConnectionManager.Cache.Set(locationCacheKey, matrix)
 	return matrix
 }
+
+// BuildComputeZoneList :: return a list of matrix items, one per zone specified
+func BuildComputeZoneList(ctx context.Context, d *plugin.QueryData) []map[string]interface{} {
+
+	// have we already created and cached the zones?
+	zoneCacheKey := "ComputeZone"
+	if cachedData, ok := d.ConnectionManager.Cache.Get(zoneCacheKey); ok {
+		plugin.Logger(ctx).Trace("listZoneDetails:", cachedData.([]map[string]interface{}))
+		return cachedData.([]map[string]interface{})
+	}
+
+	// Create Service Connection
+	service, err := ComputeService(ctx, d)
+	if err != nil {
+		return nil
+	}
+
+	// Get project details
+	projectData, err := activeProject(ctx, d)
+	if err != nil {
+		return nil
+	}
+	project := projectData.Project
+
+	var matrix []map[string]interface{}
+	resp := service.Zones.List(project)
+	if err := resp.Pages(ctx, func(page *compute.ZoneList) error {
+		for _, zone := range page.Items {
+			matrix = append(matrix, map[string]interface{}{matrixKeyLocation: zone.Name})
+		}
+		return nil
+	}); err != nil {
+		return nil
+	}
+
+	d.ConnectionManager.Cache.Set(zoneCacheKey, matrix)
+	return matrix
+}
@@ -0,0 +1,148 @@
+package gcp
+
+import (
+	"context"
+	"strings"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/memoize"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"google.golang.org/api/compute/v1"
+)
+
+type computeEffectiveMetadataInfo = struct {
+	Metadata            map[string]string
+	OsLoginEnabled      bool
+	OsLogin2faEnabled   bool
+	SerialPortEnabled   bool
+	BlockProjectSshKeys bool
+	HasStartupScript    bool
+}
+
+// computeMetadataValue returns the value of the given key of instance or
+// project metadata, and whether the key is set
+func computeMetadataValue(metadata *compute.Metadata, key string) (string, bool) {
+	if metadata == nil {
+		return "", false
+	}
+
+	for _, item := range metadata.Items {
+		if item.Key == key {
+			return types.SafeString(item.Value), true
+		}
+	}
+
+	return "", false
+}
+
+// computeMetadataBool returns whether the given metadata key is set to a true
+// value
+func computeMetadataBool(metadata *compute.Metadata, key string) bool {
+	value, ok := computeMetadataValue(metadata, key)
+	if !ok {
+		return false
+	}
+	return isComputeMetadataTrue(value)
+}
+
+// isComputeMetadataTrue returns whether a metadata value is true. Compute
+// Engine accepts TRUE, true, 1, Y, y, YES and yes.
+func isComputeMetadataTrue(value string) bool {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "true", "1", "y", "yes":
+		return true
+	}
+	return false
+}
+
+// computeEffectiveMetadata merges the project metadata with the metadata of an
+// instance, or of the instances created from a template, the way Compute
+// Engine applies it to the VM:
+//   - instance values override project values of the same key
+//   - ssh-keys of the project and the instance are combined, unless the
+//     instance blocks project-wide SSH keys with block-project-ssh-keys or
+//     the legacy sshKeys key, in which case the project keys are dropped
+func computeEffectiveMetadata(project *compute.Metadata, instance *compute.Metadata) computeEffectiveMetadataInfo {
+	merged := map[string]string{}
+
+	_, hasLegacyKeys := computeMetadataValue(instance, "sshKeys")
+	blockProjectKeys := hasLegacyKeys || computeMetadataBool(instance, "block-project-ssh-keys")
+
+	if project != nil {
+		for _, item := range project.Items {
+			if blockProjectKeys && (item.Key == "ssh-keys" || item.Key == "sshKeys") {
+				continue
+			}
+			merged[item.Key] = types.SafeString(item.Value)
+		}
+	}
+
+	if instance != nil {
+		for _, item := range instance.Items {
+			value := types.SafeString(item.Value)
+			if item.Key == "ssh-keys" && merged["ssh-keys"] != "" {
+				value = strings.TrimRight(merged["ssh-keys"], "\n") + "\n" + value
+			}
+			merged[item.Key] = value
+		}
+	}
+
+	_, hasStartupScript := merged["startup-script"]
+	_, hasStartupScriptUrl := merged["startup-script-url"]
+	_, hasWindowsStartupScript := merged["windows-startup-script-ps1"]
+
+	return computeEffectiveMetadataInfo{
+		Metadata:            merged,
+		OsLoginEnabled:      isComputeMetadataTrue(merged["enable-oslogin"]),
+		OsLogin2faEnabled:   isComputeMetadataTrue(merged["enable-oslogin"]) && isComputeMetadataTrue(merged["enable-oslogin-2fa"]),
+		SerialPortEnabled:   isComputeMetadataTrue(merged["serial-port-enable"]),
+		BlockProjectSshKeys: blockProjectKeys,
+		HasStartupScript:    hasStartupScript || hasStartupScriptUrl || hasWindowsStartupScript,
+	}
+}
+
+//// HYDRATE FUNCTIONS
+
+// getComputeProjectCommonInstanceMetadata returns the project-wide metadata
+// of the project. It is cached per connection, since every instance of the
+// project shares it.
+func getComputeProjectCommonInstanceMetadata(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	metadata, err := getComputeProjectCommonInstanceMetadataMemoized(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+
+	return metadata, nil
+}
+
+var getComputeProjectCommonInstanceMetadataMemoized = plugin.HydrateFunc(getComputeProjectCommonInstanceMetadataUncached).Memoize(memoize.WithCacheKeyFunction(getComputeProjectCommonInstanceMetadataCacheKey))
+
+// Build a cache key for the call to getComputeProjectCommonInstanceMetadata.
+func getComputeProjectCommonInstanceMetadataCacheKey(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	return "getComputeProjectCommonInstanceMetadata", nil
+}
+
+func getComputeProjectCommonInstanceMetadataUncached(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Create Service Connection
+	service, err := ComputeService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	resp, err := service.Projects.Get(project).Fields("commonInstanceMetadata").Do()
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.CommonInstanceMetadata == nil {
+		return &compute.Metadata{}, nil
+	}
+	return resp.CommonInstanceMetadata, nil
+}
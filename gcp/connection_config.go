@@ -12,6 +12,8 @@ type gcpConfig struct {
   	QuotaProject              *string  `hcl:"quota_project,optional"`
 	IgnoreErrorMessages       []string `hcl:"ignore_error_messages,optional"`
 	IgnoreErrorCodes          []string `hcl:"ignore_error_codes,optional"`
+	ObjectContentMaxBytes     *int64   `hcl:"storage_object_content_max_bytes,optional"`
+	LogEntryDefaultWindow     *string  `hcl:"log_entry_default_window,optional"`
 }
 
 func ConfigInstance() interface{} {
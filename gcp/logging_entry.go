@@ -0,0 +1,207 @@
+package gcp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+
+	"google.golang.org/api/logging/v2"
+)
+
+// Queries of log entries without a condition on the timestamps are restricted
+// to this window, unless log_entry_default_window is set in the connection
+// config
+const defaultLoggingEntryWindow = "24h"
+
+// loggingEntryTimestampRestriction matches a filter with a condition on the
+// top-level timestamp or receiveTimestamp fields, e.g. timestamp >= "2024-01-01",
+// but not on fields of the payload, e.g. jsonPayload.timestamp_ms > 5
+var loggingEntryTimestampRestriction = regexp.MustCompile(`(^|[^\w.])(timestamp|receiveTimestamp)\s*(!=|>=|<=|=|>|<|:)`)
+
+// loggingEntryPayloadInfo is the row of the tables of the logs written with
+// a JSON payload, e.g. VPC flow logs, with the payload decoded so that its
+// fields can be used in transforms
+type loggingEntryPayloadInfo = struct {
+	Entry   *logging.LogEntry
+	Payload map[string]interface{}
+}
+
+// listLoggingEntriesOfLogs streams the entries of the given logs of the
+// connection project, e.g. cloudaudit.googleapis.com%2Factivity, as converted
+// by newItem. The entries are restricted by the given filters, the quals of
+// the filterQuals columns and the filter column.
+func listLoggingEntriesOfLogs(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData, logIds []string, filters []string, filterQuals []filterQualMap, newItem func(*logging.LogEntry) (interface{}, error)) error {
+	// Create Service Connection
+	service, err := LoggingService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error(d.Table.Name+".listLoggingEntriesOfLogs", "service_error", err)
+		return err
+	}
+
+	// Max limit isn't mentioned in the documentation
+	// Default limit is set as 10000, like gcp_logging_log_entry
+	pageSize := types.Int64(10000)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return err
+	}
+	project := projectId.(string)
+
+	var logNames []string
+	for _, logId := range logIds {
+		logNames = append(logNames, "\"projects/"+project+"/logs/"+logId+"\"")
+	}
+	filters = append([]string{"logName = (" + strings.Join(logNames, " OR ") + ")"}, filters...)
+
+	if filter := buildLoggingFilterFromQuals(d.Quals, filterQuals); filter != "" {
+		filters = append(filters, filter)
+	}
+	if filter := d.EqualsQualString("filter"); filter != "" {
+		filters = append(filters, "("+filter+")")
+	}
+
+	// Restrict the entries to the default window of the connection, if the
+	// query has no condition on the timestamps
+	windowFilter, err := getLoggingEntryDefaultWindowFilter(d)
+	if err != nil {
+		plugin.Logger(ctx).Error(d.Table.Name+".listLoggingEntriesOfLogs", "invalid_default_window", err)
+		return err
+	}
+	if windowFilter != "" {
+		filters = append(filters, windowFilter)
+	}
+
+	orderBy, err := getLoggingEntryOrderBy(d)
+	if err != nil {
+		plugin.Logger(ctx).Error(d.Table.Name+".listLoggingEntriesOfLogs", "invalid_order_by", err)
+		return err
+	}
+
+	param := &logging.ListLogEntriesRequest{
+		PageSize:      *pageSize,
+		ResourceNames: []string{"projects/" + project},
+		Filter:        strings.Join(filters, " AND "),
+		OrderBy:       orderBy,
+	}
+
+	if err := service.Entries.List(param).Pages(ctx, func(page *logging.ListLogEntriesResponse) error {
+		for _, entry := range page.Entries {
+			item, err := newItem(entry)
+			if err != nil {
+				plugin.Logger(ctx).Error(d.Table.Name+".listLoggingEntriesOfLogs", "parse_error", err)
+				return err
+			}
+			d.StreamListItem(ctx, item)
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				return nil
+			}
+		}
+		return nil
+	}); err != nil {
+		plugin.Logger(ctx).Error(d.Table.Name+".listLoggingEntriesOfLogs", "api_error", err)
+		return err
+	}
+
+	return nil
+}
+
+// newLoggingEntryPayloadInfo decodes the JSON payload of a log entry
+func newLoggingEntryPayloadInfo(entry *logging.LogEntry) (interface{}, error) {
+	info := loggingEntryPayloadInfo{Entry: entry, Payload: map[string]interface{}{}}
+	if len(entry.JsonPayload) == 0 {
+		return info, nil
+	}
+
+	if err := json.Unmarshal(entry.JsonPayload, &info.Payload); err != nil {
+		return info, err
+	}
+
+	return info, nil
+}
+
+// getLoggingEntryDefaultWindowFilter returns a filter restricting the log
+// entries to the log_entry_default_window connection setting, 24h by default,
+// when the query has no condition on timestamp or receive_timestamp, including
+// in the filter column. An empty or zero setting disables the window.
+func getLoggingEntryDefaultWindowFilter(d *plugin.QueryData) (string, error) {
+	defaultWindow := defaultLoggingEntryWindow
+	if config := GetConfig(d.Connection); config.LogEntryDefaultWindow != nil {
+		defaultWindow = *config.LogEntryDefaultWindow
+	}
+	if defaultWindow == "" {
+		return "", nil
+	}
+
+	if d.Quals["timestamp"] != nil || d.Quals["receive_timestamp"] != nil {
+		return "", nil
+	}
+	if loggingEntryTimestampRestriction.MatchString(d.EqualsQualString("filter")) {
+		return "", nil
+	}
+
+	window, err := time.ParseDuration(defaultWindow)
+	if err != nil {
+		return "", fmt.Errorf("invalid log_entry_default_window %q, it must be a duration like 24h: %v", defaultWindow, err)
+	}
+	if window <= 0 {
+		return "", nil
+	}
+
+	return "timestamp >= \"" + time.Now().Add(-window).UTC().Format(time.RFC3339) + "\"", nil
+}
+
+// getLoggingEntryOrderBy returns the order of the log entries to request, from
+// the order_by column if the table has one, or the order by clause of the query
+// if it is on timestamp. An empty order is the default, timestamp asc.
+func getLoggingEntryOrderBy(d *plugin.QueryData) (string, error) {
+	var qualOrder string
+	switch d.EqualsQualString("order_by") {
+	case "asc":
+		qualOrder = "timestamp asc"
+	case "desc":
+		qualOrder = "timestamp desc"
+	case "":
+	default:
+		return "", fmt.Errorf("order_by must be asc or desc")
+	}
+
+	// Only the first column of the order by clause can be pushed down
+	var sortOrder string
+	if sort := d.QueryContext.SortOrder; len(sort) > 0 && sort[0].Column == "timestamp" {
+		switch sort[0].Order {
+		case plugin.SortAsc:
+			sortOrder = "timestamp asc"
+		case plugin.SortDesc:
+			sortOrder = "timestamp desc"
+		}
+	}
+
+	// The entries are returned in the order of the order by clause, which
+	// can't be honoured if order_by asks for the opposite order
+	if qualOrder != "" && sortOrder != "" && qualOrder != sortOrder {
+		return "", fmt.Errorf("order_by %q conflicts with the order by clause of the query on timestamp", d.EqualsQualString("order_by"))
+	}
+	if qualOrder != "" {
+		return qualOrder, nil
+	}
+
+	return sortOrder, nil
+}
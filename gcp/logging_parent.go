@@ -0,0 +1,61 @@
+package gcp
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+// getLoggingParent returns the parent of the logging resources to query, which
+// is the parent given in the where clause, e.g. organizations/123456789,
+// folders/123456789 or billingAccounts/012345-567890-ABCDEF, or the project of
+// the connection otherwise
+func getLoggingParent(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	if parent := d.EqualsQualString("parent"); parent != "" {
+		// The parent column returns the parent as given, so it must be the
+		// exact resource name for the rows to match the where clause
+		if strings.HasSuffix(parent, "/") {
+			return nil, fmt.Errorf("invalid parent %q, it must not end with a /", parent)
+		}
+		return parent, nil
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+
+	return "projects/" + projectId.(string), nil
+}
+
+// getLoggingParentProject returns the project of the parent of the logging
+// resources, or nil if the parent is an organization, folder or billing account
+func getLoggingParentProject(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	parent, err := getLoggingParent(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+
+	return loggingResourceProject(parent.(string)), nil
+}
+
+// loggingResourceProject returns the project of a logging resource name, e.g.
+// projects/my-project/locations/global/buckets/_Default, or nil if the resource
+// doesn't belong to a project
+func loggingResourceProject(name string) interface{} {
+	splitName := strings.Split(name, "/")
+	if len(splitName) > 1 && splitName[0] == "projects" {
+		return splitName[1]
+	}
+	return nil
+}
+
+// loggingResourceNameProject is the transform of loggingResourceProject, for
+// columns of the project of a resource name, e.g. the name of a log
+func loggingResourceNameProject(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	return loggingResourceProject(d.Value.(string)), nil
+}
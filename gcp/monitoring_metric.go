@@ -243,6 +243,13 @@ type PointWithTimeStamp struct {
 	// Point Value
 	Point float64
 
+	// Statistics of the samples of the point, which is a single sample of the
+	// point value unless the point is a distribution
+	Minimum     float64
+	Maximum     float64
+	Sum         float64
+	SampleCount float64
+
 	// Time stamp of the point value
 	TimeStamp string
 }
@@ -266,14 +273,14 @@ func metricstatistic(granularity string, points []*monitoring.Point, ctx context
 		pointValueType := value.Value
 		timeStamp := value.Interval.StartTime
 
-		// TODO: Need to handle BoolType, StringValue and DistributionValue
+		// TODO: Need to handle BoolType
 		if pointValueType.DoubleValue != nil {
-			pointValues = append(pointValues, &PointWithTimeStamp{Point: *pointValueType.DoubleValue, TimeStamp: timeStamp})
+			pointValues = append(pointValues, newPointWithTimeStamp(*pointValueType.DoubleValue, timeStamp))
 		}
 
 		if pointValueType.Int64Value != nil {
 			val := float64(*pointValueType.Int64Value)
-			pointValues = append(pointValues, &PointWithTimeStamp{Point: val, TimeStamp: timeStamp})
+			pointValues = append(pointValues, newPointWithTimeStamp(val, timeStamp))
 		}
 
 		if pointValueType.StringValue != nil {
@@ -281,14 +288,36 @@ func metricstatistic(granularity string, points []*monitoring.Point, ctx context
 			if err != nil {
 				return nil, err
 			}
-			pointValues = append(pointValues, &PointWithTimeStamp{Point: val, TimeStamp: timeStamp})
+			pointValues = append(pointValues, newPointWithTimeStamp(val, timeStamp))
+		}
+
+		// A distribution point summarizes several samples, with their mean and,
+		// if set, their range. Points without samples are skipped.
+		if distribution := pointValueType.DistributionValue; distribution != nil && distribution.Count > 0 {
+			point := &PointWithTimeStamp{
+				Point:       distribution.Mean,
+				Minimum:     distribution.Mean,
+				Maximum:     distribution.Mean,
+				Sum:         distribution.Mean * float64(distribution.Count),
+				SampleCount: float64(distribution.Count),
+				TimeStamp:   timeStamp,
+			}
+			if distribution.Range != nil {
+				point.Minimum, point.Maximum = distribution.Range.Min, distribution.Range.Max
+			}
+			pointValues = append(pointValues, point)
 		}
 	}
 
+	// Series without numeric or distribution points have no statistics
+	if len(pointValues) == 0 {
+		return nil, nil
+	}
+
 	// Initialize max and min value with first point value
 	var sum, average, sampleCount float64
-	minValue := pointValues[0].Point
-	maxValue := minValue
+	minValue := pointValues[0].Minimum
+	maxValue := pointValues[0].Maximum
 
 	startTime := pointValues[0].TimeStamp
 	var timeDiff float64
@@ -306,7 +335,6 @@ func metricstatistic(granularity string, points []*monitoring.Point, ctx context
 
 		// Check time diff(DAILY, HOURLY) and push the details to statistics
 		if timeDiff >= interval {
-			sampleCount = float64(pointCount)
 			average = sum / sampleCount
 			statistics = append(statistics, &Statistics{
 				Maximum:     maxValue,
@@ -316,29 +344,29 @@ func metricstatistic(granularity string, points []*monitoring.Point, ctx context
 				SampleCount: sampleCount,
 				TimeStamp:   startTime,
 			})
-			maxValue, minValue = pointValues[pointCount].Point, pointValues[pointCount].Point
-			pointCount, sum, diffCheckExecuted = 0, 0.0, true
+			maxValue, minValue = pointValues[pointCount].Maximum, pointValues[pointCount].Minimum
+			pointCount, sum, sampleCount, diffCheckExecuted = 0, 0.0, 0.0, true
 
 			// Set the time interval as per granularity
 			currentStartTime, _ := time.Parse(time.RFC3339, startTime)
 			startTime = currentStartTime.Add(-time.Second * getIncrementalTimeAsPerGranularity(granularity)).Format(time.RFC3339)
 		}
 
-		if point.Point > maxValue {
-			maxValue = point.Point
+		if point.Maximum > maxValue {
+			maxValue = point.Maximum
 		}
-		if point.Point < minValue {
-			minValue = point.Point
+		if point.Minimum < minValue {
+			minValue = point.Minimum
 		}
 
-		sum += point.Point
+		sum += point.Sum
+		sampleCount += point.SampleCount
 		pointCount++
 		pointIndex++
 	}
 
 	// Left over points which is not with in the same time interval
 	if pointIndex == int64(len(pointValues)) && !diffCheckExecuted {
-		sampleCount = float64(pointCount)
 		average = sum / sampleCount
 		statistics = append(statistics, &Statistics{
 			Maximum:     maxValue,
@@ -353,6 +381,18 @@ func metricstatistic(granularity string, points []*monitoring.Point, ctx context
 	return statistics, nil
 }
 
+// newPointWithTimeStamp returns a point with a single sample of the value
+func newPointWithTimeStamp(value float64, timeStamp string) *PointWithTimeStamp {
+	return &PointWithTimeStamp{
+		Point:       value,
+		Minimum:     value,
+		Maximum:     value,
+		Sum:         value,
+		SampleCount: 1,
+		TimeStamp:   timeStamp,
+	}
+}
+
 // Check time difference in second
 func checkTimeDiff(startTime string, endTime string) float64 {
 	dt1, err := time.Parse(time.RFC3339, startTime)
@@ -0,0 +1,60 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"google.golang.org/api/networkconnectivity/v1"
+)
+
+// BuildNetworkConnectivityLocationList :: return a list of matrix items, one per location specified
+func BuildNetworkConnectivityLocationList(ctx context.Context, d *plugin.QueryData) []map[string]interface{} {
+
+	// have we already created and cached the locations?
+	locationCacheKey := "BuildNetworkConnectivityLocationList"
+	if cachedData, ok := d.ConnectionManager.Cache.Get(locationCacheKey); ok {
+		plugin.Logger(ctx).Debug("BuildNetworkConnectivityLocationList:", cachedData.([]map[string]interface{}))
+		return cachedData.([]map[string]interface{})
+	}
+
+	// Create Service Connection
+	service, err := NetworkConnectivityService(ctx, d)
+	if err != nil {
+		return nil
+	}
+
+	// Get project details
+	projectData, err := activeProject(ctx, d)
+	if err != nil {
+		return nil
+	}
+	project := projectData.Project
+
+	resp := service.Projects.Locations.List("projects/" + project)
+
+	var locations []*networkconnectivity.Location
+
+	if err := resp.Pages(ctx, func(page *networkconnectivity.ListLocationsResponse) error {
+		locations = append(locations, page.Locations...)
+		return nil
+	}); err != nil {
+		return nil
+	}
+
+	// validate location list
+	// VPC spokes and hubs live in the global location, which is not always
+	// returned by the locations API
+	hasGlobal := false
+	matrix := make([]map[string]interface{}, 0, len(locations)+1)
+	for _, location := range locations {
+		if location.LocationId == "global" {
+			hasGlobal = true
+		}
+		matrix = append(matrix, map[string]interface{}{matrixKeyLocation: location.LocationId})
+	}
+	if !hasGlobal {
+		matrix = append(matrix, map[string]interface{}{matrixKeyLocation: "global"})
+	}
+	d.ConnectionManager.Cache.Set(locationCacheKey, matrix)
+	return matrix
+}
@@ -77,6 +77,7 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			"gcp_apikeys_key":                                         tableGcpApiKeysKey(ctx),
 			"gcp_app_engine_application":                              tableGcpAppEngineApplication(ctx),
 			"gcp_artifact_registry_repository":                        tableGcpArtifactRegistryRepository(ctx),
+			"gcp_audit_log":                                           tableGcpAuditLog(ctx),
 			"gcp_audit_policy":                                        tableGcpAuditPolicy(ctx),
 			"gcp_bigquery_dataset":                                    tableGcpBigQueryDataset(ctx),
 			"gcp_bigquery_job":                                        tableGcpBigQueryJob(ctx),
@@ -85,6 +86,11 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			"gcp_bigtable_cluster":                                    tableGcpBigtableCluster(ctx),
 			"gcp_billing_account":                                     tableGcpBillingAccount(ctx),
 			"gcp_billing_budget":                                      tableGcpBillingBudget(ctx),
+			"gcp_certificate_manager_certificate":                     tableGcpCertificateManagerCertificate(ctx),
+			"gcp_certificate_manager_certificate_map":                 tableGcpCertificateManagerCertificateMap(ctx),
+			"gcp_certificate_manager_certificate_map_entry":           tableGcpCertificateManagerCertificateMapEntry(ctx),
+			"gcp_certificate_manager_dns_authorization":               tableGcpCertificateManagerDnsAuthorization(ctx),
+			"gcp_certificate_manager_trust_config":                    tableGcpCertificateManagerTrustConfig(ctx),
 			"gcp_cloud_asset":                                         tableGcpCloudAsset(ctx),
 			"gcp_cloud_identity_group":                                tableGcpCloudIdentityGroup(ctx),
 			"gcp_cloud_identity_group_membership":                     tableGcpCloudIdentityGroupMembership(ctx),
@@ -92,47 +98,76 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			"gcp_cloud_run_job":                                       tableGcpCloudRunJob(ctx),
 			"gcp_cloud_run_service":                                   tableGcpCloudRunService(ctx),
 			"gcp_composer_environment":                                tableGcpComposerEnvironment(ctx),
+			"gcp_compute_accelerator_type":                            tableGcpComputeAcceleratorType(ctx),
 			"gcp_compute_address":                                     tableGcpComputeAddress(ctx),
 			"gcp_compute_autoscaler":                                  tableGcpComputeAutoscaler(ctx),
 			"gcp_compute_backend_bucket":                              tableGcpComputeBackendBucket(ctx),
 			"gcp_compute_backend_service":                             tableGcpComputeBackendService(ctx),
+			"gcp_compute_backend_service_health":                      tableGcpComputeBackendServiceHealth(ctx),
+			"gcp_compute_commitment":                                  tableGcpComputeCommitment(ctx),
 			"gcp_compute_disk":                                        tableGcpComputeDisk(ctx),
+			"gcp_compute_disk_backup_coverage":                        tableGcpComputeDiskBackupCoverage(ctx),
 			"gcp_compute_disk_metric_read_ops":                        tableGcpComputeDiskMetricReadOps(ctx),
 			"gcp_compute_disk_metric_read_ops_daily":                  tableGcpComputeDiskMetricReadOpsDaily(ctx),
 			"gcp_compute_disk_metric_read_ops_hourly":                 tableGcpComputeDiskMetricReadOpsHourly(ctx),
 			"gcp_compute_disk_metric_write_ops":                       tableGcpComputeDiskMetricWriteOps(ctx),
 			"gcp_compute_disk_metric_write_ops_daily":                 tableGcpComputeDiskMetricWriteOpsDaily(ctx),
 			"gcp_compute_disk_metric_write_ops_hourly":                tableGcpComputeDiskMetricWriteOpsHourly(ctx),
+			"gcp_compute_external_vpn_gateway":                        tableGcpComputeExternalVpnGateway(ctx),
 			"gcp_compute_firewall":                                    tableGcpComputeFirewall(ctx),
+			"gcp_compute_firewall_rule_log":                           tableGcpComputeFirewallRuleLog(ctx),
 			"gcp_compute_forwarding_rule":                             tableGcpComputeForwardingRule(ctx),
 			"gcp_compute_global_address":                              tableGcpComputeGlobalAddress(ctx),
 			"gcp_compute_global_forwarding_rule":                      tableGcpComputeGlobalForwardingRule(ctx),
 			"gcp_compute_ha_vpn_gateway":                              tableGcpComputeHaVpnGateway(ctx),
+			"gcp_compute_health_check":                                tableGcpComputeHealthCheck(ctx),
 			"gcp_compute_image":                                       tableGcpComputeImage(ctx),
 			"gcp_compute_instance":                                    tableGcpComputeInstance(ctx),
 			"gcp_compute_instance_group":                              tableGcpComputeInstanceGroup(ctx),
+			"gcp_compute_instance_group_managed_instance":             tableGcpComputeInstanceGroupManagedInstance(ctx),
 			"gcp_compute_instance_group_manager":                      tableGcpComputeInstanceGroupManager(ctx),
+			"gcp_compute_instance_guest_attribute":                    tableGcpComputeInstanceGuestAttribute(ctx),
 			"gcp_compute_instance_metric_cpu_utilization":             tableGcpComputeInstanceMetricCpuUtilization(ctx),
 			"gcp_compute_instance_metric_cpu_utilization_daily":       tableGcpComputeInstanceMetricCpuUtilizationDaily(ctx),
 			"gcp_compute_instance_metric_cpu_utilization_hourly":      tableGcpComputeInstanceMetricCpuUtilizationHourly(ctx),
+			"gcp_compute_instance_serial_port_output":                 tableGcpComputeInstanceSerialPortOutput(ctx),
 			"gcp_compute_instance_template":                           tableGcpComputeInstanceTemplate(ctx),
+			"gcp_compute_interconnect":                                tableGcpComputeInterconnect(ctx),
+			"gcp_compute_interconnect_attachment":                     tableGcpComputeInterconnectAttachment(ctx),
+			"gcp_compute_load_balancer_request_log":                   tableGcpComputeLoadBalancerRequestLog(ctx),
 			"gcp_compute_machine_image":                               tableGcpComputeMachineImage(ctx),
 			"gcp_compute_machine_type":                                tableGcpComputeMachineType(ctx),
 			"gcp_compute_network":                                     tableGcpComputeNetwork(ctx),
+			"gcp_compute_network_endpoint":                            tableGcpComputeNetworkEndpoint(ctx),
+			"gcp_compute_network_endpoint_group":                      tableGcpComputeNetworkEndpointGroup(ctx),
+			"gcp_compute_network_peering":                             tableGcpComputeNetworkPeering(ctx),
 			"gcp_compute_node_group":                                  tableGcpComputeNodeGroup(ctx),
+			"gcp_compute_node_group_node":                             tableGcpComputeNodeGroupNode(ctx),
 			"gcp_compute_node_template":                               tableGcpComputeNodeTemplate(ctx),
 			"gcp_compute_project_metadata":                            tableGcpComputeProjectMetadata(ctx),
+			"gcp_compute_quota":                                       tableGcpComputeQuota(ctx),
 			"gcp_compute_region":                                      tableGcpComputeRegion(ctx),
+			"gcp_compute_reservation":                                 tableGcpComputeReservation(ctx),
 			"gcp_compute_resource_policy":                             tableGcpComputeResourcePolicy(ctx),
 			"gcp_compute_router":                                      tableGcpComputeRouter(ctx),
+			"gcp_compute_security_policy":                             tableGcpComputeSecurityPolicy(ctx),
+			"gcp_compute_security_policy_rule":                        tableGcpComputeSecurityPolicyRule(ctx),
+			"gcp_compute_service_attachment":                          tableGcpComputeServiceAttachment(ctx),
+			"gcp_compute_shared_vpc_service_project":                  tableGcpComputeSharedVpcServiceProject(ctx),
 			"gcp_compute_snapshot":                                    tableGcpComputeSnapshot(ctx),
+			"gcp_compute_ssh_key":                                     tableGcpComputeSshKey(ctx),
+			"gcp_compute_ssl_certificate":                             tableGcpComputeSslCertificate(ctx),
 			"gcp_compute_ssl_policy":                                  tableGcpComputeSslPolicy(ctx),
 			"gcp_compute_subnetwork":                                  tableGcpComputeSubnetwork(ctx),
+			"gcp_compute_target_grpc_proxy":                           tableGcpComputeTargetGrpcProxy(ctx),
+			"gcp_compute_target_http_proxy":                           tableGcpComputeTargetHttpProxy(ctx),
 			"gcp_compute_target_https_proxy":                          tableGcpComputeTargetHttpsProxy(ctx),
 			"gcp_compute_target_pool":                                 tableGcpComputeTargetPool(ctx),
 			"gcp_compute_target_ssl_proxy":                            tableGcpComputeTargetSslProxy(ctx),
+			"gcp_compute_target_tcp_proxy":                            tableGcpComputeTargetTcpProxy(ctx),
 			"gcp_compute_target_vpn_gateway":                          tableGcpComputeTargetVpnGateway(ctx),
 			"gcp_compute_url_map":                                     tableGcpComputeURLMap(ctx),
+			"gcp_compute_vpc_flow_log":                                tableGcpComputeVpcFlowLog(ctx),
 			"gcp_compute_vpn_tunnel":                                  tableGcpComputeVpnTunnel(ctx),
 			"gcp_compute_zone":                                        tableGcpComputeZone(ctx),
 			"gcp_dataplex_asset":                                      tableGcpDataplexAsset(ctx),
@@ -152,27 +187,50 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			"gcp_kms_key_version":                                     tableGcpKmsKeyVersion(ctx),
 			"gcp_kubernetes_cluster":                                  tableGcpKubernetesCluster(ctx),
 			"gcp_kubernetes_node_pool":                                tableGcpKubernetesNodePool(ctx),
+			"gcp_logging_analytics_query":                             tableGcpLoggingAnalyticsQuery(ctx),
 			"gcp_logging_bucket":                                      tableGcpLoggingBucket(ctx),
 			"gcp_logging_exclusion":                                   tableGcpLoggingExclusion(ctx),
+			"gcp_logging_link":                                        tableGcpLoggingLink(ctx),
+			"gcp_logging_log":                                         tableGcpLoggingLog(ctx),
 			"gcp_logging_log_entry":                                   tableGcpLoggingLogEntry(ctx),
 			"gcp_logging_metric":                                      tableGcpLoggingMetric(ctx),
+			"gcp_logging_metric_time_series":                          tableGcpLoggingMetricTimeSeries(ctx),
+			"gcp_logging_metric_time_series_daily":                    tableGcpLoggingMetricTimeSeriesDaily(ctx),
+			"gcp_logging_metric_time_series_hourly":                   tableGcpLoggingMetricTimeSeriesHourly(ctx),
+			"gcp_logging_settings":                                    tableGcpLoggingSettings(ctx),
 			"gcp_logging_sink":                                        tableGcpLoggingSink(ctx),
+			"gcp_logging_view":                                        tableGcpLoggingView(ctx),
 			"gcp_monitoring_alert_policy":                             tableGcpMonitoringAlert(ctx),
 			"gcp_monitoring_group":                                    tableGcpMonitoringGroup(ctx),
 			"gcp_monitoring_notification_channel":                     tableGcpMonitoringNotificationChannel(ctx),
+			"gcp_network_connectivity_hub":                            tableGcpNetworkConnectivityHub(ctx),
+			"gcp_network_connectivity_spoke":                          tableGcpNetworkConnectivitySpoke(ctx),
 			"gcp_organization":                                        tableGcpOrganization(ctx),
 			"gcp_organization_project":                                tableGcpOrganizationProject(ctx),
+			"gcp_osconfig_inventory":                                  tableGcpOSConfigInventory(ctx),
+			"gcp_osconfig_os_policy_assignment_report":                tableGcpOSConfigOSPolicyAssignmentReport(ctx),
+			"gcp_osconfig_patch_deployment":                           tableGcpOSConfigPatchDeployment(ctx),
+			"gcp_osconfig_patch_job":                                  tableGcpOSConfigPatchJob(ctx),
+			"gcp_osconfig_patch_job_instance_detail":                  tableGcpOSConfigPatchJobInstanceDetail(ctx),
+			"gcp_osconfig_vulnerability_report":                       tableGcpOSConfigVulnerabilityReport(ctx),
+			"gcp_oslogin_login_profile":                               tableGcpOSLoginLoginProfile(ctx),
+			"gcp_privateca_ca_pool":                                   tableGcpPrivateCACaPool(ctx),
+			"gcp_privateca_certificate":                               tableGcpPrivateCACertificate(ctx),
+			"gcp_privateca_certificate_authority":                     tableGcpPrivateCACertificateAuthority(ctx),
 			"gcp_project":                                             tableGcpProject(ctx),
 			"gcp_project_organization_policy":                         tableGcpProjectOrganizationPolicy(ctx),
 			"gcp_project_service":                                     tableGcpProjectService(ctx),
 			"gcp_pubsub_snapshot":                                     tableGcpPubSubSnapshot(ctx),
 			"gcp_pubsub_subscription":                                 tableGcpPubSubSubscription(ctx),
 			"gcp_pubsub_topic":                                        tableGcpPubSubTopic(ctx),
+			"gcp_quota_info":                                          tableGcpQuotaInfo(ctx),
+			"gcp_quota_preference":                                    tableGcpQuotaPreference(ctx),
 			"gcp_redis_cluster":                                       tableGcpRedisCluster(ctx),
 			"gcp_redis_instance":                                      tableGcpRedisInstance(ctx),
 			"gcp_secret_manager_secret":                               tableGcpSecretManagerSecret(ctx),
 			"gcp_service_account":                                     tableGcpServiceAccount(ctx),
 			"gcp_service_account_key":                                 tableGcpServiceAccountKey(ctx),
+			"gcp_service_networking_connection":                       tableGcpServiceNetworkingConnection(ctx),
 			"gcp_sql_backup":                                          tableGcpSQLBackup(ctx),
 			"gcp_sql_database":                                        tableGcpSQLDatabase(ctx),
 			"gcp_sql_database_instance":                               tableGcpSQLDatabaseInstance(ctx),
@@ -182,9 +240,20 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			"gcp_sql_database_instance_metric_cpu_utilization":        tableGcpSQLDatabaseInstanceMetricCpuUtilization(ctx),
 			"gcp_sql_database_instance_metric_cpu_utilization_daily":  tableGcpSQLDatabaseInstanceMetricCpuUtilizationDaily(ctx),
 			"gcp_sql_database_instance_metric_cpu_utilization_hourly": tableGcpSQLDatabaseInstanceMetricCpuUtilizationHourly(ctx),
+			"gcp_storage_anywhere_cache":                              tableGcpStorageAnywhereCache(ctx),
 			"gcp_storage_bucket":                                      tableGcpStorageBucket(ctx),
+			"gcp_storage_hmac_key":                                    tableGcpStorageHmacKey(ctx),
+			"gcp_storage_insights_report_config":                      tableGcpStorageInsightsReportConfig(ctx),
+			"gcp_storage_insights_report_object":                      tableGcpStorageInsightsReportObject(ctx),
+			"gcp_storage_managed_folder":                              tableGcpStorageManagedFolder(ctx),
+			"gcp_storage_notification":                                tableGcpStorageNotification(ctx),
 			"gcp_storage_object":                                      tableGcpStorageObject(ctx),
+			"gcp_storage_object_content":                              tableGcpStorageObjectContent(ctx),
+			"gcp_storage_transfer_job":                                tableGcpStorageTransferJob(ctx),
+			"gcp_storage_transfer_operation":                          tableGcpStorageTransferOperation(ctx),
 			"gcp_tag_binding":                                         tableGcpTagBinding(ctx),
+			"gcp_tpu_node":                                            tableGcpTPUNode(ctx),
+			"gcp_tpu_queued_resource":                                 tableGcpTPUQueuedResource(ctx),
 			"gcp_vertex_ai_endpoint":                                  tableGcpVertexAIEndpoint(ctx),
 			"gcp_vertex_ai_notebook_runtime_template":                 tableGcpVertexAINotebookRuntimeTemplate(ctx),
 			"gcp_vertex_ai_model":                                     tableGcpVertexAIModel(ctx),
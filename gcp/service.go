@@ -15,6 +15,7 @@ import (
 	"google.golang.org/api/bigquery/v2"
 	"google.golang.org/api/bigtableadmin/v2"
 	"google.golang.org/api/billingbudgets/v1"
+	"google.golang.org/api/certificatemanager/v1"
 	"google.golang.org/api/cloudasset/v1"
 	"google.golang.org/api/cloudbilling/v1"
 	"google.golang.org/api/cloudfunctions/v2"
@@ -33,17 +34,25 @@ import (
 	"google.golang.org/api/logging/v2"
 	"google.golang.org/api/metastore/v1"
 	"google.golang.org/api/monitoring/v3"
+	"google.golang.org/api/networkconnectivity/v1"
 	"google.golang.org/api/option"
+	"google.golang.org/api/osconfig/v1"
+	"google.golang.org/api/oslogin/v1"
+	"google.golang.org/api/privateca/v1"
 	"google.golang.org/api/pubsub/v1"
 	run1 "google.golang.org/api/run/v1"
 	"google.golang.org/api/run/v2"
 	"google.golang.org/api/secretmanager/v1"
+	"google.golang.org/api/servicenetworking/v1"
 	"google.golang.org/api/serviceusage/v1"
 	"google.golang.org/api/storage/v1"
+	"google.golang.org/api/storagetransfer/v1"
+	"google.golang.org/api/tpu/v2"
 	"google.golang.org/api/vpcaccess/v1"
 
 	computeBeta "google.golang.org/api/compute/v0.beta"
 	sqladmin "google.golang.org/api/sqladmin/v1beta4"
+	htransport "google.golang.org/api/transport/http"
 )
 
 // AccessApprovalService returns the service connection for GCP Project AccessApproval service
@@ -897,3 +906,225 @@ func VPCAccessService(ctx context.Context, d *plugin.QueryData) (*vpcaccess.Serv
 	d.ConnectionManager.Cache.Set(serviceCacheKey, svc)
 	return svc, nil
 }
+
+// CertificateManagerService returns the service connection for GCP Certificate Manager service
+func CertificateManagerService(ctx context.Context, d *plugin.QueryData) (*certificatemanager.Service, error) {
+	// have we already created and cached the service?
+	serviceCacheKey := "CertificateManagerService"
+	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
+		return cachedData.(*certificatemanager.Service), nil
+	}
+
+	// To get config arguments from plugin config file
+	opts := setSessionConfig(ctx, d.Connection)
+
+	// so it was not in cache - create service
+	svc, err := certificatemanager.NewService(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	d.ConnectionManager.Cache.Set(serviceCacheKey, svc)
+	return svc, nil
+}
+
+// PrivateCAService returns the service connection for GCP Certificate Authority service
+func PrivateCAService(ctx context.Context, d *plugin.QueryData) (*privateca.Service, error) {
+	// have we already created and cached the service?
+	serviceCacheKey := "PrivateCAService"
+	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
+		return cachedData.(*privateca.Service), nil
+	}
+
+	// To get config arguments from plugin config file
+	opts := setSessionConfig(ctx, d.Connection)
+
+	// so it was not in cache - create service
+	svc, err := privateca.NewService(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	d.ConnectionManager.Cache.Set(serviceCacheKey, svc)
+	return svc, nil
+}
+
+// ServiceNetworkingService returns the service connection for GCP Service Networking service
+func ServiceNetworkingService(ctx context.Context, d *plugin.QueryData) (*servicenetworking.APIService, error) {
+	// have we already created and cached the service?
+	serviceCacheKey := "ServiceNetworkingService"
+	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
+		return cachedData.(*servicenetworking.APIService), nil
+	}
+
+	// To get config arguments from plugin config file
+	opts := setSessionConfig(ctx, d.Connection)
+
+	// so it was not in cache - create service
+	svc, err := servicenetworking.NewService(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	d.ConnectionManager.Cache.Set(serviceCacheKey, svc)
+	return svc, nil
+}
+
+// NetworkConnectivityService returns the service connection for GCP Network Connectivity service
+func NetworkConnectivityService(ctx context.Context, d *plugin.QueryData) (*networkconnectivity.Service, error) {
+	// have we already created and cached the service?
+	serviceCacheKey := "NetworkConnectivityService"
+	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
+		return cachedData.(*networkconnectivity.Service), nil
+	}
+
+	// To get config arguments from plugin config file
+	opts := setSessionConfig(ctx, d.Connection)
+
+	// so it was not in cache - create service
+	svc, err := networkconnectivity.NewService(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	d.ConnectionManager.Cache.Set(serviceCacheKey, svc)
+	return svc, nil
+}
+
+// CloudQuotasService returns the service connection for GCP Cloud Quotas service
+func CloudQuotasService(ctx context.Context, d *plugin.QueryData) (*cloudQuotasService, error) {
+	// have we already created and cached the service?
+	serviceCacheKey := "CloudQuotasService"
+	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
+		return cachedData.(*cloudQuotasService), nil
+	}
+
+	// To get config arguments from plugin config file
+	opts := setSessionConfig(ctx, d.Connection)
+	opts = append(opts, option.WithScopes("https://www.googleapis.com/auth/cloud-platform"))
+
+	// so it was not in cache - create service
+	client, endpoint, err := htransport.NewClient(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if endpoint == "" {
+		endpoint = cloudQuotasBasePath
+	}
+
+	svc := &cloudQuotasService{client: client, basePath: endpoint}
+
+	d.ConnectionManager.Cache.Set(serviceCacheKey, svc)
+	return svc, nil
+}
+
+// OSConfigService returns the service connection for GCP OS Config service
+func OSConfigService(ctx context.Context, d *plugin.QueryData) (*osconfig.Service, error) {
+	// have we already created and cached the service?
+	serviceCacheKey := "OSConfigService"
+	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
+		return cachedData.(*osconfig.Service), nil
+	}
+
+	// To get config arguments from plugin config file
+	opts := setSessionConfig(ctx, d.Connection)
+
+	// so it was not in cache - create service
+	svc, err := osconfig.NewService(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	d.ConnectionManager.Cache.Set(serviceCacheKey, svc)
+	return svc, nil
+}
+
+// OSLoginService returns the service connection for GCP OS Login service
+func OSLoginService(ctx context.Context, d *plugin.QueryData) (*oslogin.Service, error) {
+	// have we already created and cached the service?
+	serviceCacheKey := "OSLoginService"
+	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
+		return cachedData.(*oslogin.Service), nil
+	}
+
+	// To get config arguments from plugin config file
+	opts := setSessionConfig(ctx, d.Connection)
+
+	// so it was not in cache - create service
+	svc, err := oslogin.NewService(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	d.ConnectionManager.Cache.Set(serviceCacheKey, svc)
+	return svc, nil
+}
+
+// TPUService returns the service connection for GCP Cloud TPU service
+func TPUService(ctx context.Context, d *plugin.QueryData) (*tpu.Service, error) {
+	// have we already created and cached the service?
+	serviceCacheKey := "TPUService"
+	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
+		return cachedData.(*tpu.Service), nil
+	}
+
+	// To get config arguments from plugin config file
+	opts := setSessionConfig(ctx, d.Connection)
+
+	// so it was not in cache - create service
+	svc, err := tpu.NewService(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	d.ConnectionManager.Cache.Set(serviceCacheKey, svc)
+	return svc, nil
+}
+
+// StorageInsightsService returns the service connection for the GCP Storage Insights service
+func StorageInsightsService(ctx context.Context, d *plugin.QueryData) (*storageInsightsService, error) {
+	// have we already created and cached the service?
+	serviceCacheKey := "StorageInsightsService"
+	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
+		return cachedData.(*storageInsightsService), nil
+	}
+
+	// To get config arguments from plugin config file
+	opts := setSessionConfig(ctx, d.Connection)
+	opts = append(opts, option.WithScopes("https://www.googleapis.com/auth/cloud-platform"))
+
+	// so it was not in cache - create service
+	client, endpoint, err := htransport.NewClient(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if endpoint == "" {
+		endpoint = storageInsightsBasePath
+	}
+
+	svc := &storageInsightsService{client: client, basePath: endpoint}
+
+	d.ConnectionManager.Cache.Set(serviceCacheKey, svc)
+	return svc, nil
+}
+
+// StorageTransferService returns the service connection for the GCP Storage Transfer service
+func StorageTransferService(ctx context.Context, d *plugin.QueryData) (*storagetransfer.Service, error) {
+	// have we already created and cached the service?
+	serviceCacheKey := "StorageTransferService"
+	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
+		return cachedData.(*storagetransfer.Service), nil
+	}
+
+	// To get config arguments from plugin config file
+	opts := setSessionConfig(ctx, d.Connection)
+
+	// so it was not in cache - create service
+	svc, err := storagetransfer.NewService(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	d.ConnectionManager.Cache.Set(serviceCacheKey, svc)
+	return svc, nil
+}
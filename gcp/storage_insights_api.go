@@ -0,0 +1,194 @@
+package gcp
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"google.golang.org/api/googleapi"
+)
+
+// The Storage Insights API has no discovery based client in the version of
+// google.golang.org/api used by the plugin, so the few read-only methods used
+// by the gcp_storage_insights_* tables are called directly over an
+// authenticated client.
+//
+// https://cloud.google.com/storage/docs/insights/reference/rest
+
+const storageInsightsBasePath = "https://storageinsights.googleapis.com/"
+
+type storageInsightsService struct {
+	client   *http.Client
+	basePath string
+}
+
+type storageInsightsLocation struct {
+	Name        string            `json:"name,omitempty"`
+	LocationId  string            `json:"locationId,omitempty"`
+	DisplayName string            `json:"displayName,omitempty"`
+	Labels      map[string]string `json:"labels,omitempty"`
+}
+
+type storageInsightsReportConfig struct {
+	Name                        string                                  `json:"name,omitempty"`
+	DisplayName                 string                                  `json:"displayName,omitempty"`
+	CreateTime                  string                                  `json:"createTime,omitempty"`
+	UpdateTime                  string                                  `json:"updateTime,omitempty"`
+	FrequencyOptions            *storageInsightsFrequencyOptions        `json:"frequencyOptions,omitempty"`
+	CsvOptions                  *storageInsightsCsvOptions              `json:"csvOptions,omitempty"`
+	ParquetOptions              *struct{}                               `json:"parquetOptions,omitempty"`
+	ObjectMetadataReportOptions *storageInsightsObjectMetadataReportOps `json:"objectMetadataReportOptions,omitempty"`
+	Labels                      map[string]string                       `json:"labels,omitempty"`
+}
+
+type storageInsightsFrequencyOptions struct {
+	Frequency string               `json:"frequency,omitempty"`
+	StartDate *storageInsightsDate `json:"startDate,omitempty"`
+	EndDate   *storageInsightsDate `json:"endDate,omitempty"`
+}
+
+type storageInsightsDate struct {
+	Year  int64 `json:"year,omitempty"`
+	Month int64 `json:"month,omitempty"`
+	Day   int64 `json:"day,omitempty"`
+}
+
+type storageInsightsCsvOptions struct {
+	RecordSeparator string `json:"recordSeparator,omitempty"`
+	Delimiter       string `json:"delimiter,omitempty"`
+	HeaderRequired  bool   `json:"headerRequired,omitempty"`
+}
+
+type storageInsightsObjectMetadataReportOps struct {
+	MetadataFields []string `json:"metadataFields,omitempty"`
+	StorageFilters *struct {
+		Bucket string `json:"bucket,omitempty"`
+	} `json:"storageFilters,omitempty"`
+	StorageDestinationOptions *struct {
+		Bucket          string `json:"bucket,omitempty"`
+		DestinationPath string `json:"destinationPath,omitempty"`
+	} `json:"storageDestinationOptions,omitempty"`
+}
+
+type storageInsightsReportDetail struct {
+	Name             string `json:"name,omitempty"`
+	SnapshotTime     string `json:"snapshotTime,omitempty"`
+	ReportPathPrefix string `json:"reportPathPrefix,omitempty"`
+	ShardsCount      int64  `json:"shardsCount,omitempty,string"`
+	Status           *struct {
+		Code    int64  `json:"code,omitempty"`
+		Message string `json:"message,omitempty"`
+	} `json:"status,omitempty"`
+	ReportMetrics *struct {
+		ProcessedRecordsCount int64 `json:"processedRecordsCount,omitempty,string"`
+	} `json:"reportMetrics,omitempty"`
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+type storageInsightsListLocationsResponse struct {
+	Locations     []*storageInsightsLocation `json:"locations,omitempty"`
+	NextPageToken string                     `json:"nextPageToken,omitempty"`
+}
+
+type storageInsightsListReportConfigsResponse struct {
+	ReportConfigs []*storageInsightsReportConfig `json:"reportConfigs,omitempty"`
+	NextPageToken string                         `json:"nextPageToken,omitempty"`
+	Unreachable   []string                       `json:"unreachable,omitempty"`
+}
+
+type storageInsightsListReportDetailsResponse struct {
+	ReportDetails []*storageInsightsReportDetail `json:"reportDetails,omitempty"`
+	NextPageToken string                         `json:"nextPageToken,omitempty"`
+	Unreachable   []string                       `json:"unreachable,omitempty"`
+}
+
+// listLocations calls the ListLocations method for the given project, e.g.
+// projects/my-project
+func (s *storageInsightsService) listLocations(ctx context.Context, name string, pageToken string) (*storageInsightsListLocationsResponse, error) {
+	params := url.Values{}
+	if pageToken != "" {
+		params.Set("pageToken", pageToken)
+	}
+
+	resp := &storageInsightsListLocationsResponse{}
+	if err := s.get(ctx, "v1/"+name+"/locations", params, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// listReportConfigs calls the ListReportConfigs method for the given parent,
+// e.g. projects/my-project/locations/us-central1
+func (s *storageInsightsService) listReportConfigs(ctx context.Context, parent string, pageSize int64, pageToken string) (*storageInsightsListReportConfigsResponse, error) {
+	params := url.Values{}
+	params.Set("pageSize", strconv.FormatInt(pageSize, 10))
+	if pageToken != "" {
+		params.Set("pageToken", pageToken)
+	}
+
+	resp := &storageInsightsListReportConfigsResponse{}
+	if err := s.get(ctx, "v1/"+parent+"/reportConfigs", params, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// getReportConfig calls the GetReportConfig method for the given report
+// config name
+func (s *storageInsightsService) getReportConfig(ctx context.Context, name string) (*storageInsightsReportConfig, error) {
+	resp := &storageInsightsReportConfig{}
+	if err := s.get(ctx, "v1/"+name, url.Values{}, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// listReportDetails calls the ListReportDetails method for the given report
+// config name
+func (s *storageInsightsService) listReportDetails(ctx context.Context, parent string, pageSize int64, pageToken string) (*storageInsightsListReportDetailsResponse, error) {
+	params := url.Values{}
+	params.Set("pageSize", strconv.FormatInt(pageSize, 10))
+	if pageToken != "" {
+		params.Set("pageToken", pageToken)
+	}
+
+	resp := &storageInsightsListReportDetailsResponse{}
+	if err := s.get(ctx, "v1/"+parent+"/reportDetails", params, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// getReportDetail calls the GetReportDetail method for the given report
+// detail name
+func (s *storageInsightsService) getReportDetail(ctx context.Context, name string) (*storageInsightsReportDetail, error) {
+	resp := &storageInsightsReportDetail{}
+	if err := s.get(ctx, "v1/"+name, url.Values{}, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// get sends a GET request to the given path and decodes the JSON response into
+// out. Errors are returned as *googleapi.Error, like the generated clients.
+func (s *storageInsightsService) get(ctx context.Context, path string, params url.Values, out interface{}) error {
+	params.Set("alt", "json")
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.basePath+path+"?"+params.Encode(), nil)
+	if err != nil {
+		return err
+	}
+
+	res, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if err := googleapi.CheckResponse(res); err != nil {
+		return err
+	}
+
+	return json.NewDecoder(res.Body).Decode(out)
+}
@@ -0,0 +1,50 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+// BuildStorageInsightsLocationList :: return a list of matrix items, one per location specified
+func BuildStorageInsightsLocationList(ctx context.Context, d *plugin.QueryData) []map[string]interface{} {
+
+	// have we already created and cached the locations?
+	locationCacheKey := "BuildStorageInsightsLocationList"
+	if cachedData, ok := d.ConnectionManager.Cache.Get(locationCacheKey); ok {
+		plugin.Logger(ctx).Debug("BuildStorageInsightsLocationList:", cachedData.([]map[string]interface{}))
+		return cachedData.([]map[string]interface{})
+	}
+
+	// Create Service Connection
+	service, err := StorageInsightsService(ctx, d)
+	if err != nil {
+		return nil
+	}
+
+	// Get project details
+	projectData, err := activeProject(ctx, d)
+	if err != nil {
+		return nil
+	}
+	project := projectData.Project
+
+	var matrix []map[string]interface{}
+	pageToken := ""
+	for {
+		resp, err := service.listLocations(ctx, "projects/"+project, pageToken)
+		if err != nil {
+			return nil
+		}
+		for _, location := range resp.Locations {
+			matrix = append(matrix, map[string]interface{}{matrixKeyLocation: location.LocationId})
+		}
+		if resp.NextPageToken == "" {
+			break
+		}
+		pageToken = resp.NextPageToken
+	}
+
+	d.ConnectionManager.Cache.Set(locationCacheKey, matrix)
+	return matrix
+}
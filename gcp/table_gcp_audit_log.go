@@ -0,0 +1,300 @@
+package gcp
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"google.golang.org/api/logging/v2"
+)
+
+// The audit logs written by Google Cloud services, by log type
+var auditLogTypes = []string{"activity", "data_access", "system_event", "policy"}
+
+type auditLogInfo = struct {
+	Entry        *logging.LogEntry
+	AuditLog     *auditLogPayload
+	ProtoPayload interface{}
+}
+
+// auditLogPayload is the part of the google.cloud.audit.AuditLog payload of
+// audit log entries exposed as columns
+type auditLogPayload struct {
+	ServiceName        string `json:"serviceName"`
+	MethodName         string `json:"methodName"`
+	ResourceName       string `json:"resourceName"`
+	AuthenticationInfo *struct {
+		PrincipalEmail               string        `json:"principalEmail"`
+		PrincipalSubject             string        `json:"principalSubject"`
+		ServiceAccountDelegationInfo []interface{} `json:"serviceAccountDelegationInfo"`
+	} `json:"authenticationInfo"`
+	AuthorizationInfo []interface{} `json:"authorizationInfo"`
+	RequestMetadata   *struct {
+		CallerIp                string `json:"callerIp"`
+		CallerSuppliedUserAgent string `json:"callerSuppliedUserAgent"`
+	} `json:"requestMetadata"`
+	Status *struct {
+		Code    int64  `json:"code"`
+		Message string `json:"message"`
+	} `json:"status"`
+	Request  interface{} `json:"request"`
+	Response interface{} `json:"response"`
+}
+
+//// TABLE DEFINITION
+
+func tableGcpAuditLog(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_audit_log",
+		Description: "GCP Audit Log",
+		List: &plugin.ListConfig{
+			Hydrate: listAuditLogs,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "log_type", Require: plugin.Optional},
+				{Name: "principal_email", Require: plugin.Optional},
+				{Name: "method_name", Require: plugin.Optional},
+				{Name: "service_name", Require: plugin.Optional},
+				{Name: "resource_name", Require: plugin.Optional},
+				{Name: "caller_ip", Require: plugin.Optional},
+				{Name: "severity", Require: plugin.Optional},
+				{Name: "resource_type", Require: plugin.Optional},
+				{Name: "receive_timestamp", Require: plugin.Optional, Operators: []string{"=", ">", "<", ">=", "<="}},
+				{Name: "timestamp", Require: plugin.Optional, Operators: []string{"=", ">", "<", ">=", "<="}},
+				{Name: "filter", Require: plugin.Optional, CacheMatch: "exact"},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "log_name",
+				Description: "The resource name of the audit log the entry belongs to, e.g. projects/my-project/logs/cloudaudit.googleapis.com%2Factivity.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Entry.LogName"),
+			},
+			{
+				Name:        "log_type",
+				Description: "The type of the audit log, one of activity, data_access, system_event or policy.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Entry.LogName").Transform(auditLogType),
+			},
+			{
+				Name:        "insert_id",
+				Description: "A unique identifier for the log entry.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Entry.InsertId"),
+			},
+			{
+				Name:        "timestamp",
+				Description: "The time the audited operation occurred.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Entry.Timestamp"),
+				Sort:        plugin.SortAll,
+			},
+			{
+				Name:        "receive_timestamp",
+				Description: "The time the log entry was received by Logging.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Entry.ReceiveTimestamp"),
+			},
+			{
+				Name:        "severity",
+				Description: "The severity of the log entry, e.g. NOTICE for Admin Activity logs or ERROR for failed operations.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Entry.Severity"),
+			},
+			{
+				Name:        "principal_email",
+				Description: "The email address of the authenticated user or service account that made the request.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AuditLog.AuthenticationInfo.PrincipalEmail"),
+			},
+			{
+				Name:        "service_name",
+				Description: "The name of the API service performing the operation, e.g. compute.googleapis.com.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AuditLog.ServiceName"),
+			},
+			{
+				Name:        "method_name",
+				Description: "The name of the service method or operation, e.g. v1.compute.instances.insert.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AuditLog.MethodName"),
+			},
+			{
+				Name:        "resource_name",
+				Description: "The resource or collection that is the target of the operation, e.g. projects/my-project/zones/us-central1-a/instances/my-instance.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AuditLog.ResourceName"),
+			},
+			{
+				Name:        "status_code",
+				Description: "The status code of the operation, as a google.rpc.Code value. Null or 0 if the operation succeeded.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("AuditLog.Status.Code"),
+			},
+			{
+				Name:        "status_message",
+				Description: "The error message of the operation, if it failed.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AuditLog.Status.Message").NullIfZero(),
+			},
+			{
+				Name:        "caller_ip",
+				Description: "The IP address of the caller. For calls from Google internal networks, this is gce-internal-ip or private.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AuditLog.RequestMetadata.CallerIp"),
+			},
+			{
+				Name:        "caller_supplied_user_agent",
+				Description: "The user agent of the caller, as provided by the caller.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AuditLog.RequestMetadata.CallerSuppliedUserAgent"),
+			},
+			{
+				Name:        "resource_type",
+				Description: "The monitored resource type, e.g. gce_instance or project.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Entry.Resource.Type"),
+			},
+			{
+				Name:        "filter",
+				Description: "An additional filter in the Logging query language, combined with the filter of the other columns.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("filter"),
+			},
+			{
+				Name:        "authorization_info",
+				Description: "The authorization checks of the operation, including the permission and resource checked and whether access was granted.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("AuditLog.AuthorizationInfo"),
+			},
+			{
+				Name:        "service_account_delegation_info",
+				Description: "The chain of service accounts the request was delegated through, if any.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("AuditLog.AuthenticationInfo.ServiceAccountDelegationInfo"),
+			},
+			{
+				Name:        "request",
+				Description: "The operation request, if included in the audit log.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("AuditLog.Request"),
+			},
+			{
+				Name:        "response",
+				Description: "The operation response, if included in the audit log.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("AuditLog.Response"),
+			},
+			{
+				Name:        "resource",
+				Description: "The monitored resource that produced the log entry.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Entry.Resource"),
+			},
+			{
+				Name:        "operation",
+				Description: "Information about a long running operation associated with the log entry, if applicable.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Entry.Operation"),
+			},
+			{
+				Name:        "proto_payload",
+				Description: "The full audit log payload, as a google.cloud.audit.AuditLog object.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ProtoPayload"),
+			},
+			{
+				Name:        "labels",
+				Description: "A map of key, value pairs that provides additional information about the log entry.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Entry.Labels"),
+			},
+
+			// Standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Entry.InsertId"),
+			},
+
+			// Standard GCP columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromConstant("global"),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getProject,
+				Transform:   transform.FromValue(),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listAuditLogs(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Restrict the entries to the audit logs, or the given audit log
+	logTypes := auditLogTypes
+	if logType := d.EqualsQualString("log_type"); logType != "" {
+		logTypes = []string{logType}
+	}
+	var logIds []string
+	for _, logType := range logTypes {
+		logIds = append(logIds, "cloudaudit.googleapis.com%2F"+logType)
+	}
+
+	filterQuals := []filterQualMap{
+		{"principal_email", "protoPayload.authenticationInfo.principalEmail", "string"},
+		{"method_name", "protoPayload.methodName", "string"},
+		{"service_name", "protoPayload.serviceName", "string"},
+		{"resource_name", "protoPayload.resourceName", "string"},
+		{"caller_ip", "protoPayload.requestMetadata.callerIp", "string"},
+		{"severity", "severity", "string"},
+		{"resource_type", "resource.type", "string"},
+		{"receive_timestamp", "receiveTimestamp", "timestamp"},
+		{"timestamp", "timestamp", "timestamp"},
+	}
+
+	return nil, listLoggingEntriesOfLogs(ctx, d, h, logIds, nil, filterQuals, newAuditLogInfo)
+}
+
+//// TRANSFORM FUNCTIONS
+
+func auditLogType(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	logName := d.Value.(string)
+
+	_, logType, found := strings.Cut(logName, "cloudaudit.googleapis.com%2F")
+	if !found {
+		return nil, nil
+	}
+	return logType, nil
+}
+
+//// UTILITY FUNCTIONS
+
+// newAuditLogInfo decodes the AuditLog payload of an audit log entry
+func newAuditLogInfo(entry *logging.LogEntry) (interface{}, error) {
+	info := auditLogInfo{Entry: entry, AuditLog: &auditLogPayload{}}
+	if len(entry.ProtoPayload) == 0 {
+		return info, nil
+	}
+
+	if err := json.Unmarshal(entry.ProtoPayload, info.AuditLog); err != nil {
+		return info, err
+	}
+	if err := json.Unmarshal(entry.ProtoPayload, &info.ProtoPayload); err != nil {
+		return info, err
+	}
+
+	return info, nil
+}
@@ -0,0 +1,291 @@
+package gcp
+
+import (
+	"context"
+	"strings"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	"google.golang.org/api/certificatemanager/v1"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpCertificateManagerCertificate(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_certificate_manager_certificate",
+		Description: "GCP Certificate Manager Certificate",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			Hydrate:    getCertificateManagerCertificate,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listCertificateManagerCertificates,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "location", Require: plugin.Optional, Operators: []string{"="}},
+			},
+		},
+		GetMatrixItemFunc: BuildCertificateManagerLocationList,
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The resource name of the certificate, in the format projects/*/locations/*/certificates/*.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "One or more paragraphs of text description of a certificate.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "scope",
+				Description: "The scope of the certificate, such as DEFAULT, EDGE_CACHE, ALL_REGIONS or CLIENT_AUTH.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "create_time",
+				Description: "The creation timestamp of the certificate.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("CreateTime").NullIfZero(),
+			},
+			{
+				Name:        "update_time",
+				Description: "The last update timestamp of the certificate.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("UpdateTime").NullIfZero(),
+			},
+			{
+				Name:        "expire_time",
+				Description: "The expiry timestamp of the certificate.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("ExpireTime").NullIfZero(),
+			},
+			{
+				Name:        "is_managed",
+				Description: "True if the certificate is provisioned and managed by Google.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.From(certificateManagerCertificateIsManaged),
+			},
+			{
+				Name:        "managed_state",
+				Description: "The state of the managed certificate resource, such as PROVISIONING, FAILED or ACTIVE.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Managed.State"),
+			},
+			{
+				Name:        "managed_issuance_config",
+				Description: "The resource name for a CertificateIssuanceConfig used to configure private PKI certificates.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Managed.IssuanceConfig"),
+			},
+			{
+				Name:        "provisioning_issue_reason",
+				Description: "The reason the most recent attempt to provision the managed certificate failed, such as AUTHORIZATION_ISSUE or RATE_LIMITED.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Managed.ProvisioningIssue.Reason"),
+			},
+			{
+				Name:        "provisioning_issue_details",
+				Description: "Human readable explanation about the reason of the provisioning failure.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Managed.ProvisioningIssue.Details"),
+			},
+			{
+				Name:        "self_link",
+				Description: "The server-defined URL for the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(certificateManagerCertificateTurbotData, "SelfLink"),
+			},
+			{
+				Name:        "pem_certificate",
+				Description: "The PEM-encoded certificate chain.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "authorization_attempt_info",
+				Description: "Detailed state of the latest authorization attempt for each domain specified for the managed certificate.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Managed.AuthorizationAttemptInfo"),
+			},
+			{
+				Name:        "managed_dns_authorizations",
+				Description: "Authorizations that will be used for performing domain authorization of the managed certificate.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Managed.DnsAuthorizations"),
+			},
+			{
+				Name:        "managed_domains",
+				Description: "The domains for which a managed SSL certificate will be generated.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Managed.Domains"),
+			},
+			{
+				Name:        "san_dnsnames",
+				Description: "The list of Subject Alternative Names of dnsName type defined in the certificate.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "labels",
+				Description: "Set of labels associated with the certificate.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(extractLastPartSeparatedByBackslash),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Labels"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromP(certificateManagerCertificateTurbotData, "Akas"),
+			},
+
+			// standard gcp columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(certificateManagerCertificateTurbotData, "Location"),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(certificateManagerCertificateTurbotData, "Project"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listCertificateManagerCertificates(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var location string
+	matrixLocation := d.EqualsQualString(matrixKeyLocation)
+	// Since, when the service API is disabled, matrixLocation value will be nil
+	if matrixLocation != "" {
+		location = matrixLocation
+	}
+
+	// Minimize the API call with given location
+	if d.EqualsQualString("location") != "" && d.EqualsQualString("location") != location {
+		return nil, nil
+	}
+
+	// Max limit is set as per documentation
+	pageSize := types.Int64(500)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	// Create service connection
+	service, err := CertificateManagerService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_certificate_manager_certificate.listCertificateManagerCertificates", "service_error", err)
+		return nil, err
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	parent := "projects/" + project + "/locations/" + location
+
+	resp := service.Projects.Locations.Certificates.List(parent).PageSize(*pageSize)
+	if err := resp.Pages(ctx, func(page *certificatemanager.ListCertificatesResponse) error {
+		for _, item := range page.Certificates {
+			d.StreamListItem(ctx, item)
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				return nil
+			}
+		}
+		return nil
+	}); err != nil {
+		plugin.Logger(ctx).Error("gcp_certificate_manager_certificate.listCertificateManagerCertificates", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getCertificateManagerCertificate(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	matrixLocation := d.EqualsQualString(matrixKeyLocation)
+
+	name := d.EqualsQualString("name")
+
+	if name == "" {
+		return nil, nil
+	}
+
+	// Restrict the API call for other locations
+	if len(strings.Split(name, "/")) > 3 && strings.Split(name, "/")[3] != matrixLocation {
+		return nil, nil
+	}
+
+	// Create service connection
+	service, err := CertificateManagerService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_certificate_manager_certificate.getCertificateManagerCertificate", "service_error", err)
+		return nil, err
+	}
+
+	resp, err := service.Projects.Locations.Certificates.Get(name).Do()
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_certificate_manager_certificate.getCertificateManagerCertificate", "api_error", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func certificateManagerCertificateIsManaged(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	data := d.HydrateItem.(*certificatemanager.Certificate)
+	return data.Managed != nil, nil
+}
+
+func certificateManagerCertificateTurbotData(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	data := d.HydrateItem.(*certificatemanager.Certificate)
+	param := d.Param.(string)
+
+	return certificateManagerResourceTurbotData(data.Name)[param], nil
+}
+
+// certificateManagerResourceTurbotData returns the project, location, self link
+// and akas of a Certificate Manager resource from its relative resource name,
+// e.g. projects/my-project/locations/global/certificates/my-certificate
+func certificateManagerResourceTurbotData(name string) map[string]interface{} {
+	splitName := strings.Split(name, "/")
+
+	return map[string]interface{}{
+		"Project":  splitName[1],
+		"Location": splitName[3],
+		"SelfLink": "https://certificatemanager.googleapis.com/v1/" + name,
+		"Akas":     []string{"gcp://certificatemanager.googleapis.com/" + name},
+	}
+}
@@ -0,0 +1,208 @@
+package gcp
+
+import (
+	"context"
+	"strings"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	"google.golang.org/api/certificatemanager/v1"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpCertificateManagerCertificateMap(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_certificate_manager_certificate_map",
+		Description: "GCP Certificate Manager Certificate Map",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			Hydrate:    getCertificateManagerCertificateMap,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listCertificateManagerCertificateMaps,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "location", Require: plugin.Optional, Operators: []string{"="}},
+			},
+		},
+		GetMatrixItemFunc: BuildCertificateManagerLocationList,
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The resource name of the certificate map, in the format projects/*/locations/*/certificateMaps/*.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "One or more paragraphs of text description of a certificate map.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "create_time",
+				Description: "The creation timestamp of the certificate map.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("CreateTime").NullIfZero(),
+			},
+			{
+				Name:        "update_time",
+				Description: "The last update timestamp of the certificate map.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("UpdateTime").NullIfZero(),
+			},
+			{
+				Name:        "self_link",
+				Description: "The server-defined URL for the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(certificateManagerCertificateMapTurbotData, "SelfLink"),
+			},
+			{
+				Name:        "gclb_targets",
+				Description: "A list of GCLB targets that use this certificate map. A target will be created in projects/*/locations/*/targets/*.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "labels",
+				Description: "Set of labels associated with the certificate map.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(extractLastPartSeparatedByBackslash),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Labels"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromP(certificateManagerCertificateMapTurbotData, "Akas"),
+			},
+
+			// standard gcp columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(certificateManagerCertificateMapTurbotData, "Location"),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(certificateManagerCertificateMapTurbotData, "Project"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listCertificateManagerCertificateMaps(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var location string
+	matrixLocation := d.EqualsQualString(matrixKeyLocation)
+	// Since, when the service API is disabled, matrixLocation value will be nil
+	if matrixLocation != "" {
+		location = matrixLocation
+	}
+
+	// Minimize the API call with given location
+	if d.EqualsQualString("location") != "" && d.EqualsQualString("location") != location {
+		return nil, nil
+	}
+
+	// Max limit is set as per documentation
+	pageSize := types.Int64(500)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	// Create service connection
+	service, err := CertificateManagerService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_certificate_manager_certificate_map.listCertificateManagerCertificateMaps", "service_error", err)
+		return nil, err
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	parent := "projects/" + project + "/locations/" + location
+
+	resp := service.Projects.Locations.CertificateMaps.List(parent).PageSize(*pageSize)
+	if err := resp.Pages(ctx, func(page *certificatemanager.ListCertificateMapsResponse) error {
+		for _, item := range page.CertificateMaps {
+			d.StreamListItem(ctx, item)
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				return nil
+			}
+		}
+		return nil
+	}); err != nil {
+		plugin.Logger(ctx).Error("gcp_certificate_manager_certificate_map.listCertificateManagerCertificateMaps", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getCertificateManagerCertificateMap(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	matrixLocation := d.EqualsQualString(matrixKeyLocation)
+
+	name := d.EqualsQualString("name")
+
+	if name == "" {
+		return nil, nil
+	}
+
+	// Restrict the API call for other locations
+	if len(strings.Split(name, "/")) > 3 && strings.Split(name, "/")[3] != matrixLocation {
+		return nil, nil
+	}
+
+	// Create service connection
+	service, err := CertificateManagerService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_certificate_manager_certificate_map.getCertificateManagerCertificateMap", "service_error", err)
+		return nil, err
+	}
+
+	resp, err := service.Projects.Locations.CertificateMaps.Get(name).Do()
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_certificate_manager_certificate_map.getCertificateManagerCertificateMap", "api_error", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func certificateManagerCertificateMapTurbotData(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	data := d.HydrateItem.(*certificatemanager.CertificateMap)
+	param := d.Param.(string)
+
+	return certificateManagerResourceTurbotData(data.Name)[param], nil
+}
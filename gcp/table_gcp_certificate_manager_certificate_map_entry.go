@@ -0,0 +1,221 @@
+package gcp
+
+import (
+	"context"
+	"strings"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	"google.golang.org/api/certificatemanager/v1"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpCertificateManagerCertificateMapEntry(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_certificate_manager_certificate_map_entry",
+		Description: "GCP Certificate Manager Certificate Map Entry",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			Hydrate:    getCertificateManagerCertificateMapEntry,
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listCertificateManagerCertificateMaps,
+			Hydrate:       listCertificateManagerCertificateMapEntries,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "location", Require: plugin.Optional, Operators: []string{"="}},
+				{Name: "certificate_map_name", Require: plugin.Optional, Operators: []string{"="}},
+			},
+		},
+		GetMatrixItemFunc: BuildCertificateManagerLocationList,
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The resource name of the certificate map entry, in the format projects/*/locations/*/certificateMaps/*/certificateMapEntries/*.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "certificate_map_name",
+				Description: "The name of the certificate map the entry belongs to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(certificateManagerCertificateMapEntryTurbotData, "CertificateMap"),
+			},
+			{
+				Name:        "description",
+				Description: "One or more paragraphs of text description of a certificate map entry.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state",
+				Description: "The serving state of the certificate map entry, such as ACTIVE or PENDING.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "hostname",
+				Description: "A hostname or a wildcard hostname the entry applies to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "matcher",
+				Description: "A predefined matcher for particular cases, other than SNI selection. Can be PRIMARY.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "create_time",
+				Description: "The creation timestamp of the certificate map entry.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("CreateTime").NullIfZero(),
+			},
+			{
+				Name:        "update_time",
+				Description: "The last update timestamp of the certificate map entry.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("UpdateTime").NullIfZero(),
+			},
+			{
+				Name:        "self_link",
+				Description: "The server-defined URL for the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(certificateManagerCertificateMapEntryTurbotData, "SelfLink"),
+			},
+			{
+				Name:        "certificates",
+				Description: "A set of certificates defined for the map entry, in the format projects/*/locations/*/certificates/*.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "labels",
+				Description: "Set of labels associated with the certificate map entry.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(extractLastPartSeparatedByBackslash),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Labels"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromP(certificateManagerCertificateMapEntryTurbotData, "Akas"),
+			},
+
+			// standard gcp columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(certificateManagerCertificateMapEntryTurbotData, "Location"),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(certificateManagerCertificateMapEntryTurbotData, "Project"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listCertificateManagerCertificateMapEntries(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	certificateMap := h.Item.(*certificatemanager.CertificateMap)
+
+	// Minimize the API call with given certificate map
+	certificateMapName := d.EqualsQualString("certificate_map_name")
+	if certificateMapName != "" && certificateMapName != getLastPathElement(certificateMap.Name) {
+		return nil, nil
+	}
+
+	// Max limit is set as per documentation
+	pageSize := types.Int64(500)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	// Create service connection
+	service, err := CertificateManagerService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_certificate_manager_certificate_map_entry.listCertificateManagerCertificateMapEntries", "service_error", err)
+		return nil, err
+	}
+
+	resp := service.Projects.Locations.CertificateMaps.CertificateMapEntries.List(certificateMap.Name).PageSize(*pageSize)
+	if err := resp.Pages(ctx, func(page *certificatemanager.ListCertificateMapEntriesResponse) error {
+		for _, item := range page.CertificateMapEntries {
+			d.StreamListItem(ctx, item)
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				return nil
+			}
+		}
+		return nil
+	}); err != nil {
+		plugin.Logger(ctx).Error("gcp_certificate_manager_certificate_map_entry.listCertificateManagerCertificateMapEntries", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getCertificateManagerCertificateMapEntry(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	matrixLocation := d.EqualsQualString(matrixKeyLocation)
+
+	name := d.EqualsQualString("name")
+
+	if name == "" {
+		return nil, nil
+	}
+
+	// Restrict the API call for other locations
+	if len(strings.Split(name, "/")) > 3 && strings.Split(name, "/")[3] != matrixLocation {
+		return nil, nil
+	}
+
+	// Create service connection
+	service, err := CertificateManagerService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_certificate_manager_certificate_map_entry.getCertificateManagerCertificateMapEntry", "service_error", err)
+		return nil, err
+	}
+
+	resp, err := service.Projects.Locations.CertificateMaps.CertificateMapEntries.Get(name).Do()
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_certificate_manager_certificate_map_entry.getCertificateManagerCertificateMapEntry", "api_error", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func certificateManagerCertificateMapEntryTurbotData(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	data := d.HydrateItem.(*certificatemanager.CertificateMapEntry)
+	param := d.Param.(string)
+
+	turbotData := certificateManagerResourceTurbotData(data.Name)
+	turbotData["CertificateMap"] = strings.Split(data.Name, "/")[5]
+
+	return turbotData[param], nil
+}
@@ -0,0 +1,231 @@
+package gcp
+
+import (
+	"context"
+	"strings"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	"google.golang.org/api/certificatemanager/v1"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpCertificateManagerDnsAuthorization(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_certificate_manager_dns_authorization",
+		Description: "GCP Certificate Manager DNS Authorization",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			Hydrate:    getCertificateManagerDnsAuthorization,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listCertificateManagerDnsAuthorizations,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "location", Require: plugin.Optional, Operators: []string{"="}},
+			},
+		},
+		GetMatrixItemFunc: BuildCertificateManagerLocationList,
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The resource name of the DNS authorization, in the format projects/*/locations/*/dnsAuthorizations/*.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "One or more paragraphs of text description of a DNS authorization.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "domain",
+				Description: "A domain that is being authorized.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "type",
+				Description: "The type of the DNS authorization, such as FIXED_RECORD or PER_PROJECT_RECORD.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "create_time",
+				Description: "The creation timestamp of the DNS authorization.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("CreateTime").NullIfZero(),
+			},
+			{
+				Name:        "update_time",
+				Description: "The last update timestamp of the DNS authorization.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("UpdateTime").NullIfZero(),
+			},
+			{
+				Name:        "dns_resource_record_name",
+				Description: "Fully qualified name of the DNS resource record.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DnsResourceRecord.Name"),
+			},
+			{
+				Name:        "dns_resource_record_type",
+				Description: "Type of the DNS resource record, currently always CNAME.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DnsResourceRecord.Type"),
+			},
+			{
+				Name:        "dns_resource_record_data",
+				Description: "Data of the DNS resource record.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DnsResourceRecord.Data"),
+			},
+			{
+				Name:        "self_link",
+				Description: "The server-defined URL for the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(certificateManagerDnsAuthorizationTurbotData, "SelfLink"),
+			},
+			{
+				Name:        "labels",
+				Description: "Set of labels associated with the DNS authorization.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(extractLastPartSeparatedByBackslash),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Labels"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromP(certificateManagerDnsAuthorizationTurbotData, "Akas"),
+			},
+
+			// standard gcp columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(certificateManagerDnsAuthorizationTurbotData, "Location"),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(certificateManagerDnsAuthorizationTurbotData, "Project"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listCertificateManagerDnsAuthorizations(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var location string
+	matrixLocation := d.EqualsQualString(matrixKeyLocation)
+	// Since, when the service API is disabled, matrixLocation value will be nil
+	if matrixLocation != "" {
+		location = matrixLocation
+	}
+
+	// Minimize the API call with given location
+	if d.EqualsQualString("location") != "" && d.EqualsQualString("location") != location {
+		return nil, nil
+	}
+
+	// Max limit is set as per documentation
+	pageSize := types.Int64(500)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	// Create service connection
+	service, err := CertificateManagerService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_certificate_manager_dns_authorization.listCertificateManagerDnsAuthorizations", "service_error", err)
+		return nil, err
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	parent := "projects/" + project + "/locations/" + location
+
+	resp := service.Projects.Locations.DnsAuthorizations.List(parent).PageSize(*pageSize)
+	if err := resp.Pages(ctx, func(page *certificatemanager.ListDnsAuthorizationsResponse) error {
+		for _, item := range page.DnsAuthorizations {
+			d.StreamListItem(ctx, item)
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				return nil
+			}
+		}
+		return nil
+	}); err != nil {
+		plugin.Logger(ctx).Error("gcp_certificate_manager_dns_authorization.listCertificateManagerDnsAuthorizations", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getCertificateManagerDnsAuthorization(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	matrixLocation := d.EqualsQualString(matrixKeyLocation)
+
+	name := d.EqualsQualString("name")
+
+	if name == "" {
+		return nil, nil
+	}
+
+	// Restrict the API call for other locations
+	if len(strings.Split(name, "/")) > 3 && strings.Split(name, "/")[3] != matrixLocation {
+		return nil, nil
+	}
+
+	// Create service connection
+	service, err := CertificateManagerService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_certificate_manager_dns_authorization.getCertificateManagerDnsAuthorization", "service_error", err)
+		return nil, err
+	}
+
+	resp, err := service.Projects.Locations.DnsAuthorizations.Get(name).Do()
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_certificate_manager_dns_authorization.getCertificateManagerDnsAuthorization", "api_error", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func certificateManagerDnsAuthorizationTurbotData(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	data := d.HydrateItem.(*certificatemanager.DnsAuthorization)
+	param := d.Param.(string)
+
+	return certificateManagerResourceTurbotData(data.Name)[param], nil
+}
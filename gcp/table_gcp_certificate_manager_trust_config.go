@@ -0,0 +1,218 @@
+package gcp
+
+import (
+	"context"
+	"strings"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	"google.golang.org/api/certificatemanager/v1"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpCertificateManagerTrustConfig(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_certificate_manager_trust_config",
+		Description: "GCP Certificate Manager Trust Config",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			Hydrate:    getCertificateManagerTrustConfig,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listCertificateManagerTrustConfigs,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "location", Require: plugin.Optional, Operators: []string{"="}},
+			},
+		},
+		GetMatrixItemFunc: BuildCertificateManagerLocationList,
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The resource name of the trust config, in the format projects/*/locations/*/trustConfigs/*.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "One or more paragraphs of text description of a trust config.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "etag",
+				Description: "This checksum is computed by the server based on the value of other fields, and may be sent on update and delete requests to ensure the client has an up-to-date value before proceeding.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "create_time",
+				Description: "The creation timestamp of the trust config.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("CreateTime").NullIfZero(),
+			},
+			{
+				Name:        "update_time",
+				Description: "The last update timestamp of the trust config.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("UpdateTime").NullIfZero(),
+			},
+			{
+				Name:        "self_link",
+				Description: "The server-defined URL for the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(certificateManagerTrustConfigTurbotData, "SelfLink"),
+			},
+			{
+				Name:        "allowlisted_certificates",
+				Description: "A certificate matching an allowlisted certificate is always considered valid as long as the certificate is parseable, proof of private key possession is established, and constraints on the certificate's SAN field are met.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "trust_stores",
+				Description: "Set of trust stores to perform validation against.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "labels",
+				Description: "Set of labels associated with the trust config.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(extractLastPartSeparatedByBackslash),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Labels"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromP(certificateManagerTrustConfigTurbotData, "Akas"),
+			},
+
+			// standard gcp columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(certificateManagerTrustConfigTurbotData, "Location"),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(certificateManagerTrustConfigTurbotData, "Project"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listCertificateManagerTrustConfigs(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var location string
+	matrixLocation := d.EqualsQualString(matrixKeyLocation)
+	// Since, when the service API is disabled, matrixLocation value will be nil
+	if matrixLocation != "" {
+		location = matrixLocation
+	}
+
+	// Minimize the API call with given location
+	if d.EqualsQualString("location") != "" && d.EqualsQualString("location") != location {
+		return nil, nil
+	}
+
+	// Max limit is set as per documentation
+	pageSize := types.Int64(500)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	// Create service connection
+	service, err := CertificateManagerService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_certificate_manager_trust_config.listCertificateManagerTrustConfigs", "service_error", err)
+		return nil, err
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	parent := "projects/" + project + "/locations/" + location
+
+	resp := service.Projects.Locations.TrustConfigs.List(parent).PageSize(*pageSize)
+	if err := resp.Pages(ctx, func(page *certificatemanager.ListTrustConfigsResponse) error {
+		for _, item := range page.TrustConfigs {
+			d.StreamListItem(ctx, item)
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				return nil
+			}
+		}
+		return nil
+	}); err != nil {
+		plugin.Logger(ctx).Error("gcp_certificate_manager_trust_config.listCertificateManagerTrustConfigs", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getCertificateManagerTrustConfig(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	matrixLocation := d.EqualsQualString(matrixKeyLocation)
+
+	name := d.EqualsQualString("name")
+
+	if name == "" {
+		return nil, nil
+	}
+
+	// Restrict the API call for other locations
+	if len(strings.Split(name, "/")) > 3 && strings.Split(name, "/")[3] != matrixLocation {
+		return nil, nil
+	}
+
+	// Create service connection
+	service, err := CertificateManagerService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_certificate_manager_trust_config.getCertificateManagerTrustConfig", "service_error", err)
+		return nil, err
+	}
+
+	resp, err := service.Projects.Locations.TrustConfigs.Get(name).Do()
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_certificate_manager_trust_config.getCertificateManagerTrustConfig", "api_error", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func certificateManagerTrustConfigTurbotData(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	data := d.HydrateItem.(*certificatemanager.TrustConfig)
+	param := d.Param.(string)
+
+	return certificateManagerResourceTurbotData(data.Name)[param], nil
+}
@@ -0,0 +1,246 @@
+package gcp
+
+import (
+	"context"
+	"strings"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"google.golang.org/api/compute/v1"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpComputeAcceleratorType(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_compute_accelerator_type",
+		Description: "GCP Compute Accelerator Type",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "zone_name"}),
+			Hydrate:    getComputeAcceleratorType,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listComputeAcceleratorTypes,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "name", Require: plugin.Optional},
+				{Name: "zone_name", Require: plugin.Optional},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the accelerator type, e.g. nvidia-tesla-t4.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The unique identifier for the resource. This identifier is defined by the server.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "description",
+				Description: "The textual description of the accelerator type, e.g. NVIDIA T4.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "maximum_cards_per_instance",
+				Description: "The maximum number of accelerator cards allowed per instance.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "creation_timestamp",
+				Description: "Creation timestamp in RFC3339 text format.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromGo().NullIfZero(),
+			},
+			{
+				Name:        "kind",
+				Description: "The type of the resource. Always compute#acceleratorType for accelerator types.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "self_link",
+				Description: "Server-defined URL for the resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "zone",
+				Description: "The URL of the zone where the accelerator type resides.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// zone_name is a simpler view of the zone, without the full path
+			{
+				Name:        "zone_name",
+				Description: "The name of the zone where the accelerator type resides, such as us-central1-a.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Zone").Transform(lastPathElement),
+			},
+			{
+				Name:        "deprecated",
+				Description: "The deprecation status associated with this accelerator type.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromP(gcpComputeAcceleratorTypeTurbotData, "Akas"),
+			},
+
+			// GCP standard columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Zone").Transform(lastPathElement),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(gcpComputeAcceleratorTypeTurbotData, "Project"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listComputeAcceleratorTypes(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Create Service Connection
+	service, err := ComputeService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_compute_accelerator_type.listComputeAcceleratorTypes", "service_error", err)
+		return nil, err
+	}
+
+	filterQuals := []filterQualMap{
+		{"name", "name", "string"},
+	}
+
+	filters := buildQueryFilterFromQuals(filterQuals, d.Quals)
+	filterString := ""
+	if len(filters) > 0 {
+		filterString = strings.Join(filters, " ")
+	}
+
+	// Max limit is set as per documentation
+	// https://pkg.go.dev/google.golang.org/api@v0.214.0/compute/v1#AcceleratorTypesAggregatedListCall.MaxResults
+	pageSize := types.Int64(500)
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	// List the accelerator types of the given zone only, rather than of all
+	// the zones
+	if zoneName := d.EqualsQualString("zone_name"); zoneName != "" {
+		resp := service.AcceleratorTypes.List(project, zoneName).Filter(filterString).MaxResults(*pageSize)
+		if err := resp.Pages(ctx, func(page *compute.AcceleratorTypeList) error {
+			for _, acceleratorType := range page.Items {
+				d.StreamListItem(ctx, acceleratorType)
+
+				// Check if context has been cancelled or if the limit has been hit (if specified)
+				// if there is a limit, it will return the number of rows required to reach this limit
+				if d.RowsRemaining(ctx) == 0 {
+					page.NextPageToken = ""
+					return nil
+				}
+			}
+			return nil
+		}); err != nil {
+			plugin.Logger(ctx).Error("gcp_compute_accelerator_type.listComputeAcceleratorTypes", "api_error", err)
+			return nil, err
+		}
+
+		return nil, nil
+	}
+
+	resp := service.AcceleratorTypes.AggregatedList(project).Filter(filterString).MaxResults(*pageSize)
+	if err := resp.Pages(ctx, func(page *compute.AcceleratorTypeAggregatedList) error {
+		for _, item := range page.Items {
+			for _, acceleratorType := range item.AcceleratorTypes {
+				d.StreamListItem(ctx, acceleratorType)
+
+				// Check if context has been cancelled or if the limit has been hit (if specified)
+				// if there is a limit, it will return the number of rows required to reach this limit
+				if d.RowsRemaining(ctx) == 0 {
+					page.NextPageToken = ""
+					return nil
+				}
+			}
+		}
+		return nil
+	}); err != nil {
+		plugin.Logger(ctx).Error("gcp_compute_accelerator_type.listComputeAcceleratorTypes", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getComputeAcceleratorType(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	name := d.EqualsQualString("name")
+	zone := d.EqualsQualString("zone_name")
+
+	// Return nil, if no input provided
+	if name == "" || zone == "" {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := ComputeService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_compute_accelerator_type.getComputeAcceleratorType", "service_error", err)
+		return nil, err
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	resp, err := service.AcceleratorTypes.Get(project, zone, name).Do()
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_compute_accelerator_type.getComputeAcceleratorType", "api_error", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func gcpComputeAcceleratorTypeTurbotData(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	acceleratorType := d.HydrateItem.(*compute.AcceleratorType)
+	param := d.Param.(string)
+
+	project := strings.Split(acceleratorType.SelfLink, "/")[6]
+	zone := getLastPathElement(acceleratorType.Zone)
+
+	turbotData := map[string]interface{}{
+		"Project": project,
+		"Akas":    []string{"gcp://compute.googleapis.com/projects/" + project + "/zones/" + zone + "/acceleratorTypes/" + acceleratorType.Name},
+	}
+
+	return turbotData[param], nil
+}
@@ -182,6 +182,13 @@ func tableGcpComputeBackendService(ctx context.Context) *plugin.Table {
 				Description: "A list of URLs to the healthChecks, httpHealthChecks (legacy), or httpsHealthChecks (legacy) resource for health checking this backend service.",
 				Type:        proto.ColumnType_JSON,
 			},
+			{
+				Name:        "backend_health",
+				Description: "The most recent health check results for each backend group of the backend service.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getComputeBackendServiceHealth,
+				Transform:   transform.FromValue(),
+			},
 			{
 				Name:        "iap",
 				Description: "Specifies the configurations for Identity-Aware Proxy on this resource.",
@@ -343,6 +350,30 @@ func getComputeBackendService(ctx context.Context, d *plugin.QueryData, h *plugi
 	return &backendService, nil
 }
 
+func getComputeBackendServiceHealth(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	backendService := h.Item.(*compute.BackendService)
+
+	// Create Service Connection
+	service, err := ComputeService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	backendHealth := []map[string]interface{}{}
+	for _, backend := range backendService.Backends {
+		groupHealth, err := getComputeBackendServiceGroupHealth(ctx, service, backendService, backend.Group)
+		if err != nil {
+			return nil, err
+		}
+		backendHealth = append(backendHealth, map[string]interface{}{
+			"group":        backend.Group,
+			"healthStatus": groupHealth.HealthStatus,
+		})
+	}
+
+	return backendHealth, nil
+}
+
 //// TRANSFORM FUNCTIONS
 
 func gcpComputeBackendServiceAka(_ context.Context, d *transform.TransformData) (interface{}, error) {
@@ -0,0 +1,264 @@
+package gcp
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"google.golang.org/api/compute/v1"
+	"google.golang.org/api/googleapi"
+)
+
+type backendServiceHealthInfo = struct {
+	BackendService *compute.BackendService
+	Group          string
+	HealthStatus   *compute.HealthStatus
+}
+
+//// TABLE DEFINITION
+
+func tableGcpComputeBackendServiceHealth(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_compute_backend_service_health",
+		Description: "GCP Compute Backend Service Health",
+		List: &plugin.ListConfig{
+			Hydrate:       listComputeBackendServiceHealth,
+			ParentHydrate: listComputeBackendServices,
+			KeyColumns: plugin.KeyColumnSlice{
+				// String columns
+				{Name: "backend_service_name", Require: plugin.Optional},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "backend_service_name",
+				Description: "The name of the backend service.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("BackendService.Name"),
+			},
+			{
+				Name:        "backend_group",
+				Description: "The URL of the instance group or network endpoint group serving the backend service.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Group"),
+			},
+			{
+				Name:        "health_state",
+				Description: "Health state of the IPv4 address of the instance or endpoint. Can be HEALTHY or UNHEALTHY.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("HealthStatus.HealthState"),
+			},
+			{
+				Name:        "ipv6_health_state",
+				Description: "Health state of the IPv6 address of the instance or endpoint.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("HealthStatus.Ipv6HealthState"),
+			},
+			{
+				Name:        "instance",
+				Description: "URL of the instance resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("HealthStatus.Instance"),
+			},
+			{
+				Name:        "ip_address",
+				Description: "For target pool based Network Load Balancing, it indicates the forwarding rule's IP address assigned to this instance. For other types of load balancing, the field indicates VM internal ip.",
+				Type:        proto.ColumnType_IPADDR,
+				Transform:   transform.FromField("HealthStatus.IpAddress").NullIfZero(),
+			},
+			{
+				Name:        "ipv6_address",
+				Description: "The IPv6 address of the instance or endpoint.",
+				Type:        proto.ColumnType_IPADDR,
+				Transform:   transform.FromField("HealthStatus.Ipv6Address").NullIfZero(),
+			},
+			{
+				Name:        "port",
+				Description: "The named port of the instance group, not necessarily the port that is health-checked.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("HealthStatus.Port"),
+			},
+			{
+				Name:        "weight",
+				Description: "The weight of the backend, used by weighted load balancing.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("HealthStatus.Weight"),
+			},
+			{
+				Name:        "weight_error",
+				Description: "The error reported while computing the weight of the backend, if any.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("HealthStatus.WeightError"),
+			},
+			{
+				Name:        "forwarding_rule",
+				Description: "URL of the forwarding rule associated with the health status of the instance.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("HealthStatus.ForwardingRule"),
+			},
+			{
+				Name:        "forwarding_rule_ip",
+				Description: "A forwarding rule IP address assigned to this instance.",
+				Type:        proto.ColumnType_IPADDR,
+				Transform:   transform.FromField("HealthStatus.ForwardingRuleIp").NullIfZero(),
+			},
+			{
+				Name:        "annotations",
+				Description: "Metadata defined as annotations for network endpoint.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("HealthStatus.Annotations"),
+			},
+			{
+				Name:        "backend_service_self_link",
+				Description: "Server-defined URL of the backend service.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("BackendService.SelfLink"),
+			},
+
+			// standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(gcpComputeBackendServiceHealthTitle),
+			},
+
+			// standard gcp columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(gcpComputeBackendServiceHealthLocation, "Location"),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(gcpComputeBackendServiceHealthLocation, "Project"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listComputeBackendServiceHealth(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	backendService := h.Item.(*compute.BackendService)
+
+	// Minimize the API calls with the given backend service
+	backendServiceName := d.EqualsQualString("backend_service_name")
+	if backendServiceName != "" && backendServiceName != backendService.Name {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := ComputeService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_compute_backend_service_health.listComputeBackendServiceHealth", "connection_error", err)
+		return nil, err
+	}
+
+	for _, backend := range backendService.Backends {
+		groupHealth, err := getComputeBackendServiceGroupHealth(ctx, service, backendService, backend.Group)
+		if err != nil {
+			plugin.Logger(ctx).Error("gcp_compute_backend_service_health.listComputeBackendServiceHealth", "api_error", err)
+			return nil, err
+		}
+
+		for _, healthStatus := range groupHealth.HealthStatus {
+			d.StreamLeafListItem(ctx, backendServiceHealthInfo{backendService, backend.Group, healthStatus})
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+// getComputeBackendServiceGroupHealth returns the most recent health check
+// results of a single backend group of a global or regional backend service.
+// Groups without health checks, i.e. serverless, internet and Private Service
+// Connect NEGs, have no results.
+func getComputeBackendServiceGroupHealth(ctx context.Context, service *compute.Service, backendService *compute.BackendService, group string) (*compute.BackendServiceGroupHealth, error) {
+	project := strings.Split(backendService.SelfLink, "/")[6]
+	region := getLastPathElement(types.SafeString(backendService.Region))
+	req := &compute.ResourceGroupReference{Group: group}
+
+	var groupHealth *compute.BackendServiceGroupHealth
+	var err error
+	if region == "" {
+		groupHealth, err = service.BackendServices.GetHealth(project, backendService.Name, req).Context(ctx).Do()
+	} else {
+		groupHealth, err = service.RegionBackendServices.GetHealth(project, region, backendService.Name, req).Context(ctx).Do()
+	}
+	if err != nil {
+		if isBackendGroupHealthNotSupportedError(group, err) {
+			return &compute.BackendServiceGroupHealth{}, nil
+		}
+		return nil, err
+	}
+
+	return groupHealth, nil
+}
+
+// backendGroupHealthNotSupportedMessage matches the message of the error that
+// GetHealth returns for the NEGs that don't support health checks
+var backendGroupHealthNotSupportedMessage = regexp.MustCompile(`(?i)(not supported|does not support|unsupported)`)
+
+// isBackendGroupHealthNotSupportedError returns true if GetHealth failed
+// because the network endpoint group has no health checks. Any other invalid
+// argument error, or a group that is an instance group, is a real error.
+func isBackendGroupHealthNotSupportedError(group string, err error) bool {
+	gerr, ok := err.(*googleapi.Error)
+	if !ok || gerr.Code != 400 || !strings.Contains(group, "/networkEndpointGroups/") {
+		return false
+	}
+	if backendGroupHealthNotSupportedMessage.MatchString(gerr.Message) {
+		return true
+	}
+	for _, item := range gerr.Errors {
+		if backendGroupHealthNotSupportedMessage.MatchString(item.Message) {
+			return true
+		}
+	}
+	return false
+}
+
+//// TRANSFORM FUNCTIONS
+
+func gcpComputeBackendServiceHealthTitle(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	data := d.HydrateItem.(backendServiceHealthInfo)
+
+	target := getLastPathElement(data.HealthStatus.Instance)
+	if target == "" {
+		target = data.HealthStatus.IpAddress
+	}
+
+	return data.BackendService.Name + "/" + getLastPathElement(data.Group) + "/" + target, nil
+}
+
+func gcpComputeBackendServiceHealthLocation(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	data := d.HydrateItem.(backendServiceHealthInfo)
+	param := d.Param.(string)
+
+	regionName := getLastPathElement(types.SafeString(data.BackendService.Region))
+	if regionName == "" {
+		regionName = "global"
+	}
+
+	locationData := map[string]string{
+		"Location": regionName,
+		"Project":  strings.Split(data.BackendService.SelfLink, "/")[6],
+	}
+
+	return locationData[param], nil
+}
@@ -0,0 +1,310 @@
+package gcp
+
+import (
+	"context"
+	"strings"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"google.golang.org/api/compute/v1"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpComputeCommitment(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_compute_commitment",
+		Description: "GCP Compute Commitment",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			Hydrate:    getComputeCommitment,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listComputeRegionCommitments,
+			KeyColumns: plugin.KeyColumnSlice{
+				// String columns
+				{Name: "category", Require: plugin.Optional, Operators: []string{"<>", "="}},
+				{Name: "plan", Require: plugin.Optional, Operators: []string{"<>", "="}},
+				{Name: "status", Require: plugin.Optional, Operators: []string{"<>", "="}},
+				{Name: "type", Require: plugin.Optional, Operators: []string{"<>", "="}},
+				// Boolean columns
+				{Name: "auto_renew", Require: plugin.Optional, Operators: []string{"<>", "="}},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "Name of the resource. Provided by the client when the resource is created.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The unique identifier for the resource. This identifier is defined by the server.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "plan",
+				Description: "The plan for this commitment, which determines duration and discount rate, either TWELVE_MONTH or THIRTY_SIX_MONTH.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status",
+				Description: "The status of the commitment, such as NOT_YET_ACTIVE, ACTIVE or EXPIRED.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status_message",
+				Description: "An optional, human-readable explanation of the status.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "type",
+				Description: "The type of commitment, which affects the discount rate and the eligible resources, e.g. GENERAL_PURPOSE_N2 or COMPUTE_OPTIMIZED_C2D.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "category",
+				Description: "The category of the commitment, either MACHINE or LICENSE.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "auto_renew",
+				Description: "Specifies whether to enable automatic renewal for the commitment.",
+				Type:        proto.ColumnType_BOOL,
+				Default:     false,
+			},
+			{
+				Name:        "start_timestamp",
+				Description: "Commitment start time in RFC3339 text format.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "end_timestamp",
+				Description: "Commitment end time in RFC3339 text format.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "custom_end_timestamp",
+				Description: "The custom end time of the commitment in RFC3339 text format, if one was set.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("CustomEndTimestamp").NullIfZero(),
+			},
+			{
+				Name:        "creation_timestamp",
+				Description: "Creation timestamp in RFC3339 text format.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "description",
+				Description: "An optional description of this resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "kind",
+				Description: "Type of the resource. Always compute#commitment for commitments.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "split_source_commitment",
+				Description: "The source commitment from which the portion of the resources is being split.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "region",
+				Description: "The URL of the region where the commitment resides.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "self_link",
+				Description: "Server-defined URL for the resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "existing_reservations",
+				Description: "The URLs of the existing reservations that were attached to the commitment when it was created.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "license_resource",
+				Description: "The license specification required as part of a license commitment.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "merge_source_commitments",
+				Description: "The list of source commitments that are merged to create the new commitment.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "reservations",
+				Description: "The list of reservations that are attached to the commitment.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "resource_status",
+				Description: "The status information of the commitment, such as the cancellation and custom term eligibility.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "resources",
+				Description: "The list of commitment amounts for particular resources, such as VCPU and MEMORY.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromP(gcpComputeCommitmentTurbotData, "Akas"),
+			},
+
+			// standard gcp columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(gcpComputeCommitmentTurbotData, "Location"),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(gcpComputeCommitmentTurbotData, "Project"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listComputeRegionCommitments(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("listComputeRegionCommitments")
+
+	// Create Service Connection
+	service, err := ComputeService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	filterQuals := []filterQualMap{
+		{"category", "category", "string"},
+		{"plan", "plan", "string"},
+		{"status", "status", "string"},
+		{"type", "type", "string"},
+		{"auto_renew", "autoRenew", "boolean"},
+	}
+
+	filters := buildQueryFilterFromQuals(filterQuals, d.Quals)
+	filterString := ""
+	if len(filters) > 0 {
+		filterString = strings.Join(filters, " ")
+	}
+
+	// Max limit is set as per documentation
+	// https://pkg.go.dev/google.golang.org/api@v0.214.0/compute/v1#RegionCommitmentsAggregatedListCall.MaxResults
+	pageSize := types.Int64(500)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	// Get project details
+
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	resp := service.RegionCommitments.AggregatedList(project).Filter(filterString).MaxResults(*pageSize)
+	if err := resp.Pages(ctx, func(page *compute.CommitmentAggregatedList) error {
+		for _, item := range page.Items {
+			for _, commitment := range item.Commitments {
+				d.StreamListItem(ctx, commitment)
+
+				// Check if context has been cancelled or if the limit has been hit (if specified)
+				// if there is a limit, it will return the number of rows required to reach this limit
+				if d.RowsRemaining(ctx) == 0 {
+					page.NextPageToken = ""
+					return nil
+				}
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getComputeCommitment(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getComputeCommitment")
+
+	// Create Service Connection
+	service, err := ComputeService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get project details
+
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+	name := d.EqualsQuals["name"].GetStringValue()
+
+	var commitment compute.Commitment
+	resp := service.RegionCommitments.AggregatedList(project).Filter("name=" + name)
+	if err := resp.Pages(ctx, func(page *compute.CommitmentAggregatedList) error {
+		for _, item := range page.Items {
+			for _, i := range item.Commitments {
+				commitment = *i
+			}
+		}
+		return nil
+	},
+	); err != nil {
+		return nil, err
+	}
+
+	// If the specified resource is not present, API does not return any not found errors
+	if len(commitment.Name) < 1 {
+		return nil, nil
+	}
+
+	return &commitment, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func gcpComputeCommitmentTurbotData(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	data := d.HydrateItem.(*compute.Commitment)
+	param := d.Param.(string)
+
+	regionName := getLastPathElement(types.SafeString(data.Region))
+	project := strings.Split(data.SelfLink, "/")[6]
+
+	turbotData := map[string]interface{}{
+		"Location": regionName,
+		"Project":  project,
+		"Akas":     []string{"gcp://compute.googleapis.com/projects/" + project + "/regions/" + regionName + "/commitments/" + data.Name},
+	}
+
+	return turbotData[param], nil
+}
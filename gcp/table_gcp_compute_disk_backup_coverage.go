@@ -0,0 +1,394 @@
+package gcp
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"google.golang.org/api/compute/v1"
+)
+
+// Disks without a snapshot schedule are only covered by the snapshots and
+// machine images taken in this number of days, unless max_backup_age_days is
+// given in the where clause
+const defaultDiskBackupMaxAgeDays = 7
+
+type diskBackupCoverageInfo = struct {
+	Disk                     *compute.Disk
+	MaxBackupAgeDays         int64
+	SnapshotSchedulePolicies []*compute.ResourcePolicy
+	SnapshotCount            int
+	LatestSnapshotName       string
+	LatestSnapshotTime       *time.Time
+	MachineImageCount        int
+	LatestMachineImageName   string
+	LatestMachineImageTime   *time.Time
+}
+
+// diskBackups holds the number of backups of a disk and its latest backup
+type diskBackups = struct {
+	Count      int
+	LatestName string
+	LatestTime *time.Time
+}
+
+//// TABLE DEFINITION
+
+func tableGcpComputeDiskBackupCoverage(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_compute_disk_backup_coverage",
+		Description: "GCP Compute Disk Backup Coverage",
+		List: &plugin.ListConfig{
+			Hydrate: listComputeDiskBackupCoverages,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "name", Require: plugin.Optional, Operators: []string{"<>", "="}},
+				{Name: "max_backup_age_days", Require: plugin.Optional, Operators: []string{"="}, CacheMatch: "exact"},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the disk.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Disk.Name"),
+			},
+			{
+				Name:        "id",
+				Description: "The unique identifier of the disk.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Disk.Id"),
+			},
+			{
+				Name:        "self_link",
+				Description: "The server-defined URL of the disk.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Disk.SelfLink"),
+			},
+			{
+				Name:        "size_gb",
+				Description: "The size of the disk, in GB.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Disk.SizeGb"),
+			},
+			{
+				Name:        "status",
+				Description: "The status of the disk, e.g. READY.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Disk.Status"),
+			},
+			{
+				Name:        "is_covered",
+				Description: "True if the disk has a snapshot schedule, or a snapshot or machine image taken in the last max_backup_age_days days.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.From(computeDiskBackupCoverageIsCovered),
+			},
+			{
+				Name:        "max_backup_age_days",
+				Description: "The maximum age, in days, of the latest snapshot or machine image of a disk without a snapshot schedule for the disk to be covered. Defaults to 7.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "has_snapshot_schedule",
+				Description: "True if at least one snapshot schedule policy is attached to the disk.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.From(computeDiskBackupCoverageHasSnapshotSchedule),
+			},
+			{
+				Name:        "snapshot_count",
+				Description: "The number of snapshots taken from the disk.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "latest_snapshot_name",
+				Description: "The name of the latest snapshot taken from the disk.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LatestSnapshotName").NullIfZero(),
+			},
+			{
+				Name:        "latest_snapshot_time",
+				Description: "The creation time of the latest snapshot taken from the disk.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "latest_snapshot_age_days",
+				Description: "The number of days since the latest snapshot of the disk was taken.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("LatestSnapshotTime").Transform(computeDiskBackupAgeDays),
+			},
+			{
+				Name:        "machine_image_count",
+				Description: "The number of machine images that include the disk.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "latest_machine_image_name",
+				Description: "The name of the latest machine image that includes the disk.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LatestMachineImageName").NullIfZero(),
+			},
+			{
+				Name:        "latest_machine_image_time",
+				Description: "The creation time of the latest machine image that includes the disk.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "snapshot_schedule_policy_names",
+				Description: "The names of the snapshot schedule policies attached to the disk.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.From(computeDiskBackupCoveragePolicyNames),
+			},
+			{
+				Name:        "snapshot_schedule_policies",
+				Description: "The snapshot schedule policies attached to the disk, including their schedule and retention policy.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "users",
+				Description: "The URLs of the instances the disk is attached to.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Disk.Users"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Disk.Name"),
+			},
+
+			// GCP standard columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(computeDiskBackupCoverageLocation),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Disk.SelfLink").Transform(computeDiskBackupCoverageProject),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listComputeDiskBackupCoverages(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Create Service Connection
+	service, err := ComputeService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_compute_disk_backup_coverage.listComputeDiskBackupCoverages", "service_error", err)
+		return nil, err
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	maxBackupAgeDays := int64(defaultDiskBackupMaxAgeDays)
+	if d.EqualsQuals["max_backup_age_days"] != nil {
+		maxBackupAgeDays = d.EqualsQuals["max_backup_age_days"].GetInt64Value()
+	}
+
+	// Max limit is set as per documentation
+	// https://pkg.go.dev/google.golang.org/api@v0.214.0/compute/v1#SnapshotsListCall.MaxResults
+	pageSize := types.Int64(500)
+
+	// Snapshots, keyed by the ID of their source disk
+	snapshots := map[string]*diskBackups{}
+	if err := service.Snapshots.List(project).Filter("status=READY").MaxResults(*pageSize).Pages(ctx, func(page *compute.SnapshotList) error {
+		for _, snapshot := range page.Items {
+			addComputeDiskBackup(snapshots, snapshot.SourceDiskId, snapshot.Name, snapshot.CreationTimestamp)
+		}
+		return nil
+	}); err != nil {
+		plugin.Logger(ctx).Error("gcp_compute_disk_backup_coverage.listComputeDiskBackupCoverages", "api_error", err)
+		return nil, err
+	}
+
+	// Machine images, keyed by the relative resource name of their source disks.
+	// Saved disks only reference the URL of their source disk.
+	machineImages := map[string]*diskBackups{}
+	if err := service.MachineImages.List(project).MaxResults(*pageSize).Pages(ctx, func(page *compute.MachineImageList) error {
+		for _, machineImage := range page.Items {
+			if machineImage.Status != "READY" {
+				continue
+			}
+			for _, savedDisk := range machineImage.SavedDisks {
+				addComputeDiskBackup(machineImages, computeResourceRelativeName(savedDisk.SourceDisk), machineImage.Name, machineImage.CreationTimestamp)
+			}
+		}
+		return nil
+	}); err != nil {
+		plugin.Logger(ctx).Error("gcp_compute_disk_backup_coverage.listComputeDiskBackupCoverages", "api_error", err)
+		return nil, err
+	}
+
+	// Snapshot schedule policies, keyed by their relative resource name
+	policies := map[string]*compute.ResourcePolicy{}
+	if err := service.ResourcePolicies.AggregatedList(project).MaxResults(*pageSize).Pages(ctx, func(page *compute.ResourcePolicyAggregatedList) error {
+		for _, item := range page.Items {
+			for _, policy := range item.ResourcePolicies {
+				if policy.SnapshotSchedulePolicy != nil {
+					policies[computeResourceRelativeName(policy.SelfLink)] = policy
+				}
+			}
+		}
+		return nil
+	}); err != nil {
+		plugin.Logger(ctx).Error("gcp_compute_disk_backup_coverage.listComputeDiskBackupCoverages", "api_error", err)
+		return nil, err
+	}
+
+	filterQuals := []filterQualMap{
+		{"name", "name", "string"},
+	}
+
+	filters := buildQueryFilterFromQuals(filterQuals, d.Quals)
+	filterString := ""
+	if len(filters) > 0 {
+		filterString = strings.Join(filters, " ")
+	}
+
+	resp := service.Disks.AggregatedList(project).Filter(filterString).MaxResults(*pageSize)
+	if err := resp.Pages(ctx, func(page *compute.DiskAggregatedList) error {
+		for _, item := range page.Items {
+			for _, disk := range item.Disks {
+				row := diskBackupCoverageInfo{Disk: disk, MaxBackupAgeDays: maxBackupAgeDays}
+
+				for _, policy := range disk.ResourcePolicies {
+					if p, ok := policies[computeResourceRelativeName(policy)]; ok {
+						row.SnapshotSchedulePolicies = append(row.SnapshotSchedulePolicies, p)
+					}
+				}
+				if backups, ok := snapshots[strconv.FormatUint(disk.Id, 10)]; ok {
+					row.SnapshotCount = backups.Count
+					row.LatestSnapshotName = backups.LatestName
+					row.LatestSnapshotTime = backups.LatestTime
+				}
+				if backups, ok := machineImages[computeResourceRelativeName(disk.SelfLink)]; ok {
+					row.MachineImageCount = backups.Count
+					row.LatestMachineImageName = backups.LatestName
+					row.LatestMachineImageTime = backups.LatestTime
+				}
+
+				d.StreamListItem(ctx, row)
+
+				// Check if context has been cancelled or if the limit has been hit (if specified)
+				// if there is a limit, it will return the number of rows required to reach this limit
+				if d.RowsRemaining(ctx) == 0 {
+					page.NextPageToken = ""
+					return nil
+				}
+			}
+		}
+		return nil
+	}); err != nil {
+		plugin.Logger(ctx).Error("gcp_compute_disk_backup_coverage.listComputeDiskBackupCoverages", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func computeDiskBackupCoverageIsCovered(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	data := d.HydrateItem.(diskBackupCoverageInfo)
+	if len(data.SnapshotSchedulePolicies) > 0 {
+		return true, nil
+	}
+
+	// Otherwise, the latest snapshot or machine image must be recent enough
+	maxAge := time.Duration(data.MaxBackupAgeDays) * 24 * time.Hour
+	for _, backupTime := range []*time.Time{data.LatestSnapshotTime, data.LatestMachineImageTime} {
+		if backupTime != nil && time.Since(*backupTime) <= maxAge {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func computeDiskBackupCoverageHasSnapshotSchedule(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	data := d.HydrateItem.(diskBackupCoverageInfo)
+	return len(data.SnapshotSchedulePolicies) > 0, nil
+}
+
+func computeDiskBackupCoveragePolicyNames(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	data := d.HydrateItem.(diskBackupCoverageInfo)
+
+	names := []string{}
+	for _, policy := range data.SnapshotSchedulePolicies {
+		names = append(names, policy.Name)
+	}
+
+	return names, nil
+}
+
+func computeDiskBackupAgeDays(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	backupTime, ok := d.Value.(*time.Time)
+	if !ok || backupTime == nil {
+		return nil, nil
+	}
+
+	return int(time.Since(*backupTime).Hours() / 24), nil
+}
+
+func computeDiskBackupCoverageLocation(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	data := d.HydrateItem.(diskBackupCoverageInfo)
+
+	if data.Disk.Zone != "" {
+		return getLastPathElement(data.Disk.Zone), nil
+	}
+	return getLastPathElement(data.Disk.Region), nil
+}
+
+func computeDiskBackupCoverageProject(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	return strings.Split(types.SafeString(d.Value), "/")[6], nil
+}
+
+//// UTILITY FUNCTIONS
+
+// addComputeDiskBackup counts a snapshot or machine image of the disk with the
+// given key, and keeps track of the latest one
+func addComputeDiskBackup(backups map[string]*diskBackups, key string, name string, creationTimestamp string) {
+	if key == "" {
+		return
+	}
+
+	if backups[key] == nil {
+		backups[key] = &diskBackups{}
+	}
+	backups[key].Count++
+
+	creationTime, err := time.Parse(time.RFC3339, creationTimestamp)
+	if err != nil {
+		return
+	}
+	if backups[key].LatestTime == nil || creationTime.After(*backups[key].LatestTime) {
+		backups[key].LatestName = name
+		backups[key].LatestTime = &creationTime
+	}
+}
+
+// computeResourceRelativeName returns the part of a Compute Engine resource URL
+// that starts with projects/, since URLs can use either the www.googleapis.com
+// or the compute.googleapis.com host
+func computeResourceRelativeName(url string) string {
+	if i := strings.Index(url, "projects/"); i >= 0 {
+		return url[i:]
+	}
+	return url
+}
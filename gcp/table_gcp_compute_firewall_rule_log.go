@@ -0,0 +1,256 @@
+package gcp
+
+import (
+	"context"
+	"strings"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpComputeFirewallRuleLog(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_compute_firewall_rule_log",
+		Description: "GCP Compute Firewall Rule Log",
+		List: &plugin.ListConfig{
+			Hydrate: listComputeFirewallRuleLogs,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "disposition", Require: plugin.Optional},
+				{Name: "rule_reference", Require: plugin.Optional},
+				{Name: "src_ip", Require: plugin.Optional},
+				{Name: "dest_ip", Require: plugin.Optional},
+				{Name: "src_port", Require: plugin.Optional, Operators: []string{"=", ">", "<", ">=", "<="}},
+				{Name: "dest_port", Require: plugin.Optional, Operators: []string{"=", ">", "<", ">=", "<="}},
+				{Name: "protocol", Require: plugin.Optional},
+				{Name: "instance_name", Require: plugin.Optional},
+				{Name: "vpc_name", Require: plugin.Optional},
+				{Name: "receive_timestamp", Require: plugin.Optional, Operators: []string{"=", ">", "<", ">=", "<="}},
+				{Name: "timestamp", Require: plugin.Optional, Operators: []string{"=", ">", "<", ">=", "<="}},
+				{Name: "filter", Require: plugin.Optional, CacheMatch: "exact"},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "insert_id",
+				Description: "A unique identifier for the log entry.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Entry.InsertId"),
+			},
+			{
+				Name:        "timestamp",
+				Description: "The time the connection was evaluated by the firewall rule.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Entry.Timestamp"),
+				Sort:        plugin.SortAll,
+			},
+			{
+				Name:        "receive_timestamp",
+				Description: "The time the log entry was received by Logging.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Entry.ReceiveTimestamp"),
+			},
+			{
+				Name:        "disposition",
+				Description: "Whether the connection was ALLOWED or DENIED by the firewall rule.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Payload.disposition"),
+			},
+			{
+				Name:        "rule_reference",
+				Description: "The reference to the firewall rule, e.g. network:default/firewall:allow-ssh.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Payload.rule_details.reference"),
+			},
+			{
+				Name:        "rule_name",
+				Description: "The name of the VPC firewall rule, as referenced in gcp_compute_firewall. Null for firewall policy rules.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Payload.rule_details.reference").Transform(computeFirewallRuleLogRuleName),
+			},
+			{
+				Name:        "rule_action",
+				Description: "The action of the firewall rule, either ALLOW or DENY.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Payload.rule_details.action"),
+			},
+			{
+				Name:        "rule_direction",
+				Description: "The direction of the firewall rule, either INGRESS or EGRESS.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Payload.rule_details.direction"),
+			},
+			{
+				Name:        "rule_priority",
+				Description: "The priority of the firewall rule.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Payload.rule_details.priority"),
+			},
+			{
+				Name:        "src_ip",
+				Description: "The source IP address of the connection.",
+				Type:        proto.ColumnType_IPADDR,
+				Transform:   transform.FromField("Payload.connection.src_ip"),
+			},
+			{
+				Name:        "src_port",
+				Description: "The source port of the connection.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Payload.connection.src_port"),
+			},
+			{
+				Name:        "dest_ip",
+				Description: "The destination IP address of the connection.",
+				Type:        proto.ColumnType_IPADDR,
+				Transform:   transform.FromField("Payload.connection.dest_ip"),
+			},
+			{
+				Name:        "dest_port",
+				Description: "The destination port of the connection.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Payload.connection.dest_port"),
+			},
+			{
+				Name:        "protocol",
+				Description: "The IANA protocol number of the connection, e.g. 6 for TCP or 17 for UDP.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Payload.connection.protocol"),
+			},
+			{
+				Name:        "instance_name",
+				Description: "The name of the VM instance the firewall rule was applied to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Payload.instance.vm_name"),
+			},
+			{
+				Name:        "instance_zone",
+				Description: "The zone of the VM instance the firewall rule was applied to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Payload.instance.zone"),
+			},
+			{
+				Name:        "vpc_name",
+				Description: "The name of the VPC network of the VM instance.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Payload.vpc.vpc_name"),
+			},
+			{
+				Name:        "subnetwork_name",
+				Description: "The name of the subnetwork of the VM instance.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Payload.vpc.subnetwork_name"),
+			},
+			{
+				Name:        "filter",
+				Description: "An additional filter in the Logging query language, combined with the filter of the other columns.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("filter"),
+			},
+			{
+				Name:        "rule_details",
+				Description: "The details of the firewall rule, including its source and destination ranges, tags, service accounts and ports.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Payload.rule_details"),
+			},
+			{
+				Name:        "instance",
+				Description: "The details of the VM instance the firewall rule was applied to, including its project, region and zone.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Payload.instance"),
+			},
+			{
+				Name:        "vpc",
+				Description: "The details of the VPC network of the VM instance, including its project and subnetwork.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Payload.vpc"),
+			},
+			{
+				Name:        "remote_instance",
+				Description: "The details of the VM instance on the other side of the connection, if it is in the same VPC network.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Payload.remote_instance"),
+			},
+			{
+				Name:        "remote_vpc",
+				Description: "The details of the VPC network on the other side of the connection, if any.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Payload.remote_vpc"),
+			},
+			{
+				Name:        "remote_location",
+				Description: "The geographic location of the other side of the connection, if it is outside of the VPC network.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Payload.remote_location"),
+			},
+			{
+				Name:        "json_payload",
+				Description: "The full firewall rule log record.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Payload"),
+			},
+
+			// Standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Entry.InsertId"),
+			},
+
+			// Standard GCP columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Entry.Resource.Labels.location"),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getProject,
+				Transform:   transform.FromValue(),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listComputeFirewallRuleLogs(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	filterQuals := []filterQualMap{
+		{"disposition", "jsonPayload.disposition", "string"},
+		{"rule_reference", "jsonPayload.rule_details.reference", "string"},
+		{"src_ip", "jsonPayload.connection.src_ip", "ip"},
+		{"dest_ip", "jsonPayload.connection.dest_ip", "ip"},
+		{"src_port", "jsonPayload.connection.src_port", "int"},
+		{"dest_port", "jsonPayload.connection.dest_port", "int"},
+		{"protocol", "jsonPayload.connection.protocol", "int"},
+		{"instance_name", "jsonPayload.instance.vm_name", "string"},
+		{"vpc_name", "jsonPayload.vpc.vpc_name", "string"},
+		{"receive_timestamp", "receiveTimestamp", "timestamp"},
+		{"timestamp", "timestamp", "timestamp"},
+	}
+
+	logIds := []string{"compute.googleapis.com%2Ffirewall"}
+	return nil, listLoggingEntriesOfLogs(ctx, d, h, logIds, nil, filterQuals, newLoggingEntryPayloadInfo)
+}
+
+//// TRANSFORM FUNCTIONS
+
+// computeFirewallRuleLogRuleName returns the name of the VPC firewall rule of
+// a reference in the format network:NETWORK/firewall:NAME
+func computeFirewallRuleLogRuleName(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	reference, ok := d.Value.(string)
+	if !ok || !strings.HasPrefix(reference, "network:") {
+		return nil, nil
+	}
+
+	_, name, found := strings.Cut(reference, "/firewall:")
+	if !found {
+		return nil, nil
+	}
+	return name, nil
+}
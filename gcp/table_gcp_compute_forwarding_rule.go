@@ -134,6 +134,17 @@ func tableGcpComputeForwardingRule(ctx context.Context) *plugin.Table {
 				Description: "Specifies the port range. Packets addressed to ports in the specified range will be forwarded to target or backendService.",
 				Type:        proto.ColumnType_STRING,
 			},
+			{
+				Name:        "psc_connection_id",
+				Description: "The PSC connection id of the PSC forwarding rule.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("PscConnectionId").NullIfZero(),
+			},
+			{
+				Name:        "psc_connection_status",
+				Description: "The status of the Private Service Connect connection, such as ACCEPTED, PENDING, REJECTED or CLOSED.",
+				Type:        proto.ColumnType_STRING,
+			},
 			{
 				Name:        "service_label",
 				Description: "A prefix to the service name for this Forwarding Rule. If specified, the prefix is the first label of the fully qualified service name.",
@@ -0,0 +1,498 @@
+package gcp
+
+import (
+	"context"
+	"strings"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"google.golang.org/api/compute/v1"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpComputeHealthCheck(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_compute_health_check",
+		Description: "GCP Compute Health Check",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			Hydrate:    getComputeHealthCheck,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listComputeHealthChecks,
+			KeyColumns: plugin.KeyColumnSlice{
+				// String columns
+				{Name: "type", Require: plugin.Optional, Operators: []string{"<>", "="}},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "Name of the resource. Provided by the client when the resource is created.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The unique identifier for the resource. This identifier is defined by the server.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "type",
+				Description: "Specifies the type of the health check, for example TCP, SSL, HTTP, HTTPS, HTTP2 or GRPC.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "is_legacy",
+				Description: "True if the health check is a legacy HTTP or HTTPS health check.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Kind").Transform(gcpComputeHealthCheckIsLegacy),
+			},
+			{
+				Name:        "creation_timestamp",
+				Description: "Creation timestamp in RFC3339 text format.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "description",
+				Description: "An optional description of this resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "kind",
+				Description: "Type of the resource. Always compute#healthCheck for health checks, or compute#httpHealthCheck and compute#httpsHealthCheck for legacy health checks.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "check_interval_sec",
+				Description: "How often (in seconds) to send a health check.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "timeout_sec",
+				Description: "How long (in seconds) to wait before claiming failure.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "healthy_threshold",
+				Description: "A so-far unhealthy instance will be marked healthy after this many consecutive successes.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "unhealthy_threshold",
+				Description: "A so-far healthy instance will be marked unhealthy after this many consecutive failures.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "port",
+				Description: "The TCP port number to which the health check prober sends packets.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromP(gcpComputeHealthCheckProbe, "Port"),
+			},
+			{
+				Name:        "request_path",
+				Description: "The request path of the HTTP, HTTPS or HTTP/2 health check request.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(gcpComputeHealthCheckProbe, "RequestPath"),
+			},
+			{
+				Name:        "log_config_enable",
+				Description: "Indicates whether or not to export logs.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("LogConfig.Enable"),
+				Default:     false,
+			},
+			{
+				Name:        "region",
+				Description: "The URL of the region where the regional health check resides.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "self_link",
+				Description: "Server-defined URL for the resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "grpc_health_check",
+				Description: "The gRPC health check configuration.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "http_health_check",
+				Description: "The HTTP health check configuration.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "http2_health_check",
+				Description: "The HTTP/2 health check configuration.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "https_health_check",
+				Description: "The HTTPS health check configuration.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "ssl_health_check",
+				Description: "The SSL health check configuration.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "tcp_health_check",
+				Description: "The TCP health check configuration.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "source_regions",
+				Description: "The list of cloud regions from which health checks are performed.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "location_type",
+				Description: "Location type where the health check resides.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(gcpComputeHealthCheckLocation, "Type"),
+			},
+
+			// standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromP(gcpComputeHealthCheckLocation, "Akas"),
+			},
+
+			// standard gcp columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(gcpComputeHealthCheckLocation, "Location"),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(gcpComputeHealthCheckLocation, "Project"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listComputeHealthChecks(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("listComputeHealthChecks")
+
+	// Create Service Connection
+	service, err := ComputeService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	filterQuals := []filterQualMap{
+		{"type", "type", "string"},
+	}
+
+	filters := buildQueryFilterFromQuals(filterQuals, d.Quals)
+	filterString := ""
+	if len(filters) > 0 {
+		filterString = strings.Join(filters, " ")
+	}
+
+	// Max limit is set as per documentation
+	// https://pkg.go.dev/google.golang.org/api@v0.214.0/compute/v1#HealthChecksAggregatedListCall.MaxResults
+	pageSize := types.Int64(500)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	// Get project details
+
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	// Global and regional health checks
+	resp := service.HealthChecks.AggregatedList(project).Filter(filterString).MaxResults(*pageSize)
+	if err := resp.Pages(ctx, func(page *compute.HealthChecksAggregatedList) error {
+		for _, item := range page.Items {
+			for _, healthCheck := range item.HealthChecks {
+				d.StreamListItem(ctx, healthCheck)
+
+				// Check if context has been cancelled or if the limit has been hit (if specified)
+				// if there is a limit, it will return the number of rows required to reach this limit
+				if d.RowsRemaining(ctx) == 0 {
+					page.NextPageToken = ""
+					return nil
+				}
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if d.RowsRemaining(ctx) == 0 {
+		return nil, nil
+	}
+
+	// Legacy health checks are always of type HTTP or HTTPS
+	if !shouldListLegacyHealthCheck(d, "HTTP") {
+		return nil, nil
+	}
+	httpResp := service.HttpHealthChecks.List(project).MaxResults(*pageSize)
+	if err := httpResp.Pages(ctx, func(page *compute.HttpHealthCheckList) error {
+		for _, item := range page.Items {
+			d.StreamListItem(ctx, legacyHttpHealthCheckToHealthCheck(item))
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				return nil
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if d.RowsRemaining(ctx) == 0 || !shouldListLegacyHealthCheck(d, "HTTPS") {
+		return nil, nil
+	}
+	httpsResp := service.HttpsHealthChecks.List(project).MaxResults(*pageSize)
+	if err := httpsResp.Pages(ctx, func(page *compute.HttpsHealthCheckList) error {
+		for _, item := range page.Items {
+			d.StreamListItem(ctx, legacyHttpsHealthCheckToHealthCheck(item))
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				return nil
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getComputeHealthCheck(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Create Service Connection
+	service, err := ComputeService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get project details
+
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	var healthCheck compute.HealthCheck
+	name := d.EqualsQuals["name"].GetStringValue()
+
+	resp := service.HealthChecks.AggregatedList(project).Filter("name=" + name)
+	if err := resp.Pages(
+		ctx,
+		func(page *compute.HealthChecksAggregatedList) error {
+			for _, item := range page.Items {
+				for _, i := range item.HealthChecks {
+					healthCheck = *i
+				}
+			}
+			return nil
+		},
+	); err != nil {
+		return nil, err
+	}
+
+	if len(healthCheck.Name) > 0 {
+		return &healthCheck, nil
+	}
+
+	// Fall back to the legacy health checks
+	httpHealthCheck, err := service.HttpHealthChecks.Get(project, name).Do()
+	if err == nil {
+		return legacyHttpHealthCheckToHealthCheck(httpHealthCheck), nil
+	}
+	if !isIgnorableError([]string{"404"})(err) {
+		return nil, err
+	}
+
+	httpsHealthCheck, err := service.HttpsHealthChecks.Get(project, name).Do()
+	if err != nil {
+		if isIgnorableError([]string{"404"})(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return legacyHttpsHealthCheckToHealthCheck(httpsHealthCheck), nil
+}
+
+// shouldListLegacyHealthCheck checks the type quals to avoid listing legacy
+// health checks which can never match them
+func shouldListLegacyHealthCheck(d *plugin.QueryData, healthCheckType string) bool {
+	if d.Quals["type"] == nil {
+		return true
+	}
+	for _, q := range d.Quals["type"].Quals {
+		if q.Value.GetListValue() != nil {
+			matched := false
+			for _, v := range q.Value.GetListValue().Values {
+				if v.GetStringValue() == healthCheckType {
+					matched = true
+				}
+			}
+			if !matched {
+				return false
+			}
+			continue
+		}
+		value := q.Value.GetStringValue()
+		switch q.Operator {
+		case "=":
+			if value != healthCheckType {
+				return false
+			}
+		case "<>":
+			if value == healthCheckType {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// The legacy health check resources are converted into the generic health
+// check representation, so that all health checks share the same columns
+func legacyHttpHealthCheckToHealthCheck(item *compute.HttpHealthCheck) *compute.HealthCheck {
+	return &compute.HealthCheck{
+		CheckIntervalSec:   item.CheckIntervalSec,
+		CreationTimestamp:  item.CreationTimestamp,
+		Description:        item.Description,
+		HealthyThreshold:   item.HealthyThreshold,
+		Id:                 item.Id,
+		Kind:               item.Kind,
+		Name:               item.Name,
+		SelfLink:           item.SelfLink,
+		TimeoutSec:         item.TimeoutSec,
+		Type:               "HTTP",
+		UnhealthyThreshold: item.UnhealthyThreshold,
+		HttpHealthCheck: &compute.HTTPHealthCheck{
+			Host:        item.Host,
+			Port:        item.Port,
+			RequestPath: item.RequestPath,
+		},
+	}
+}
+
+func legacyHttpsHealthCheckToHealthCheck(item *compute.HttpsHealthCheck) *compute.HealthCheck {
+	return &compute.HealthCheck{
+		CheckIntervalSec:   item.CheckIntervalSec,
+		CreationTimestamp:  item.CreationTimestamp,
+		Description:        item.Description,
+		HealthyThreshold:   item.HealthyThreshold,
+		Id:                 item.Id,
+		Kind:               item.Kind,
+		Name:               item.Name,
+		SelfLink:           item.SelfLink,
+		TimeoutSec:         item.TimeoutSec,
+		Type:               "HTTPS",
+		UnhealthyThreshold: item.UnhealthyThreshold,
+		HttpsHealthCheck: &compute.HTTPSHealthCheck{
+			Host:        item.Host,
+			Port:        item.Port,
+			RequestPath: item.RequestPath,
+		},
+	}
+}
+
+//// TRANSFORM FUNCTIONS
+
+func gcpComputeHealthCheckIsLegacy(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	kind := types.SafeString(d.Value)
+	return kind == "compute#httpHealthCheck" || kind == "compute#httpsHealthCheck", nil
+}
+
+func gcpComputeHealthCheckProbe(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	healthCheck := d.HydrateItem.(*compute.HealthCheck)
+	param := d.Param.(string)
+
+	probeData := map[string]interface{}{}
+	switch {
+	case healthCheck.HttpHealthCheck != nil:
+		probeData["Port"] = healthCheck.HttpHealthCheck.Port
+		probeData["RequestPath"] = healthCheck.HttpHealthCheck.RequestPath
+	case healthCheck.HttpsHealthCheck != nil:
+		probeData["Port"] = healthCheck.HttpsHealthCheck.Port
+		probeData["RequestPath"] = healthCheck.HttpsHealthCheck.RequestPath
+	case healthCheck.Http2HealthCheck != nil:
+		probeData["Port"] = healthCheck.Http2HealthCheck.Port
+		probeData["RequestPath"] = healthCheck.Http2HealthCheck.RequestPath
+	case healthCheck.GrpcHealthCheck != nil:
+		probeData["Port"] = healthCheck.GrpcHealthCheck.Port
+	case healthCheck.SslHealthCheck != nil:
+		probeData["Port"] = healthCheck.SslHealthCheck.Port
+	case healthCheck.TcpHealthCheck != nil:
+		probeData["Port"] = healthCheck.TcpHealthCheck.Port
+	}
+
+	if port, ok := probeData["Port"].(int64); ok && port == 0 {
+		delete(probeData, "Port")
+	}
+	if path, ok := probeData["RequestPath"].(string); ok && path == "" {
+		delete(probeData, "RequestPath")
+	}
+
+	return probeData[param], nil
+}
+
+func gcpComputeHealthCheckLocation(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	healthCheck := d.HydrateItem.(*compute.HealthCheck)
+	param := d.Param.(string)
+
+	regionName := getLastPathElement(types.SafeString(healthCheck.Region))
+	project := strings.Split(healthCheck.SelfLink, "/")[6]
+
+	// Self link is in the form https://www.googleapis.com/compute/v1/projects/{project}/{global|regions/{region}}/{collection}/{name}
+	resourcePath := healthCheck.SelfLink[strings.Index(healthCheck.SelfLink, "projects/"):]
+
+	locationData := map[string]interface{}{
+		"Type":     "REGIONAL",
+		"Location": regionName,
+		"Project":  project,
+		"Akas":     []string{"gcp://compute.googleapis.com/" + resourcePath},
+	}
+
+	if regionName == "" {
+		locationData["Type"] = "GLOBAL"
+		locationData["Location"] = "global"
+	}
+
+	return locationData[param], nil
+}
@@ -195,6 +195,48 @@ func tableGcpComputeInstance(ctx context.Context) *plugin.Table {
 				Description: "The metadata key/value pairs assigned to this instance.",
 				Type:        proto.ColumnType_JSON,
 			},
+			{
+				Name:        "effective_metadata",
+				Description: "The metadata key/value pairs that apply to this instance, which are the project-wide metadata merged with the instance metadata. Instance values override project values, and project-wide SSH keys are combined with the instance SSH keys unless they are blocked.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getComputeInstanceEffectiveMetadata,
+				Transform:   transform.FromField("Metadata"),
+			},
+			{
+				Name:        "os_login_enabled",
+				Description: "True if OS Login is enabled for this instance, either in the instance or the project-wide metadata.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     getComputeInstanceEffectiveMetadata,
+				Transform:   transform.FromField("OsLoginEnabled"),
+			},
+			{
+				Name:        "os_login_2fa_enabled",
+				Description: "True if OS Login with 2-step verification is enabled for this instance.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     getComputeInstanceEffectiveMetadata,
+				Transform:   transform.FromField("OsLogin2faEnabled"),
+			},
+			{
+				Name:        "serial_port_enabled",
+				Description: "True if interactive serial console access is enabled for this instance with the serial-port-enable metadata key.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     getComputeInstanceEffectiveMetadata,
+				Transform:   transform.FromField("SerialPortEnabled"),
+			},
+			{
+				Name:        "block_project_ssh_keys",
+				Description: "True if project-wide SSH keys are blocked for this instance, either with the block-project-ssh-keys metadata key or the legacy sshKeys metadata key.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     getComputeInstanceEffectiveMetadata,
+				Transform:   transform.FromField("BlockProjectSshKeys"),
+			},
+			{
+				Name:        "has_startup_script",
+				Description: "True if a startup script is set for this instance, with the startup-script, startup-script-url or windows-startup-script-ps1 metadata keys.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     getComputeInstanceEffectiveMetadata,
+				Transform:   transform.FromField("HasStartupScript"),
+			},
 			{
 				Name:        "network_interfaces",
 				Description: "An array of network configurations for this instance.",
@@ -412,6 +454,18 @@ func getComputeInstanceIamPolicy(ctx context.Context, d *plugin.QueryData, h *pl
 	return resp, nil
 }
 
+func getComputeInstanceEffectiveMetadata(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	instance := h.Item.(*compute.Instance)
+
+	projectMetadata, err := getComputeProjectCommonInstanceMetadata(ctx, d, h)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_compute_instance.getComputeInstanceEffectiveMetadata", "api_error", err)
+		return nil, err
+	}
+
+	return computeEffectiveMetadata(projectMetadata.(*compute.Metadata), instance.Metadata), nil
+}
+
 //// TRANSFORM FUNCTION
 
 func gcpComputeInstanceTurbotData(_ context.Context, d *transform.TransformData) (interface{}, error) {
@@ -0,0 +1,314 @@
+package gcp
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"google.golang.org/api/compute/v1"
+)
+
+type instanceGroupManagedInstanceInfo = struct {
+	InstanceGroupManager *compute.InstanceGroupManager
+	ManagedInstance      *compute.ManagedInstance
+	Errors               []*compute.InstanceManagedByIgmError
+}
+
+//// TABLE DEFINITION
+
+func tableGcpComputeInstanceGroupManagedInstance(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_compute_instance_group_managed_instance",
+		Description: "GCP Compute Instance Group Managed Instance",
+		List: &plugin.ListConfig{
+			Hydrate:       listComputeInstanceGroupManagedInstances,
+			ParentHydrate: listComputeInstanceGroupManager,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "instance_group_manager_name", Require: plugin.Optional},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the instance.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ManagedInstance.Name"),
+			},
+			{
+				Name:        "id",
+				Description: "The unique identifier of the instance. Null if the instance does not exist yet.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("ManagedInstance.Id").NullIfZero(),
+			},
+			{
+				Name:        "instance_group_manager_name",
+				Description: "The name of the managed instance group the instance belongs to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("InstanceGroupManager.Name"),
+			},
+			{
+				Name:        "instance_group_manager",
+				Description: "The URL of the managed instance group the instance belongs to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("InstanceGroupManager.SelfLink"),
+			},
+			{
+				Name:        "instance",
+				Description: "The URL of the instance. The URL can exist even if the instance has not yet been created.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ManagedInstance.Instance"),
+			},
+			{
+				Name:        "current_action",
+				Description: "The current action that the managed instance group has scheduled for the instance, e.g. NONE, CREATING, RECREATING, REFRESHING, RESTARTING, VERIFYING or DELETING.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ManagedInstance.CurrentAction"),
+			},
+			{
+				Name:        "instance_status",
+				Description: "The status of the instance, e.g. RUNNING or STOPPED. Null if the instance does not exist yet.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ManagedInstance.InstanceStatus").NullIfZero(),
+			},
+			{
+				Name:        "health_state",
+				Description: "The health state of the instance reported by the autohealing health check of the group, e.g. HEALTHY, UNHEALTHY, DRAINING, TIMEOUT or UNKNOWN. Null if the group has no autohealing health check.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(computeInstanceGroupManagedInstanceHealthState),
+			},
+			{
+				Name:        "version_name",
+				Description: "The name of the version of the group the instance was created from.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ManagedInstance.Version.Name").NullIfZero(),
+			},
+			{
+				Name:        "instance_template",
+				Description: "The URL of the instance template the instance was created from.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ManagedInstance.Version.InstanceTemplate"),
+			},
+			{
+				Name:        "is_up_to_date",
+				Description: "True if the instance was created from the instance template of one of the current versions of the group. False for instances left behind by a rolling update.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.From(computeInstanceGroupManagedInstanceIsUpToDate),
+			},
+			{
+				Name:        "instance_health",
+				Description: "The health state of the instance per health check of the group.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ManagedInstance.InstanceHealth"),
+			},
+			{
+				Name:        "last_attempt_errors",
+				Description: "The errors that occurred during the last attempt to create or delete the instance.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ManagedInstance.LastAttempt.Errors.Errors"),
+			},
+			{
+				Name:        "errors",
+				Description: "The errors the managed instance group reported for the instance in the recent past, with the action, version and timestamp of each error.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "preserved_state_from_config",
+				Description: "The preserved state of the instance applied from its per-instance config.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ManagedInstance.PreservedStateFromConfig"),
+			},
+			{
+				Name:        "preserved_state_from_policy",
+				Description: "The preserved state of the instance generated based on the stateful policy of the group.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ManagedInstance.PreservedStateFromPolicy"),
+			},
+			{
+				Name:        "version",
+				Description: "The version of the group the instance was created from.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ManagedInstance.Version"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ManagedInstance.Name"),
+			},
+
+			// GCP standard columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(computeInstanceGroupManagedInstanceLocation),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("InstanceGroupManager.SelfLink").Transform(computeInstanceGroupManagedInstanceProject),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listComputeInstanceGroupManagedInstances(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	group := h.Item.(*compute.InstanceGroupManager)
+
+	// Minimize the API calls with the given instance group manager
+	groupName := d.EqualsQualString("instance_group_manager_name")
+	if groupName != "" && groupName != group.Name {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := ComputeService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_compute_instance_group_managed_instance.listComputeInstanceGroupManagedInstances", "connection_error", err)
+		return nil, err
+	}
+
+	// The errors are only listed if requested, since it is an extra call per group
+	instanceErrors := map[string][]*compute.InstanceManagedByIgmError{}
+	if slices.Contains(d.QueryContext.Columns, "errors") {
+		instanceErrors, err = listComputeInstanceGroupManagerErrors(ctx, service, group)
+		if err != nil {
+			plugin.Logger(ctx).Error("gcp_compute_instance_group_managed_instance.listComputeInstanceGroupManagedInstances", "api_error", err)
+			return nil, err
+		}
+	}
+
+	project := strings.Split(group.SelfLink, "/")[6]
+	zone := getLastPathElement(group.Zone)
+	region := getLastPathElement(group.Region)
+
+	// Max limit is set as per documentation
+	// https://pkg.go.dev/google.golang.org/api@v0.214.0/compute/v1#InstanceGroupManagersListManagedInstancesCall.MaxResults
+	pageSize := types.Int64(500)
+
+	streamInstances := func(instances []*compute.ManagedInstance) bool {
+		for _, instance := range instances {
+			d.StreamLeafListItem(ctx, instanceGroupManagedInstanceInfo{group, instance, instanceErrors[instance.Instance]})
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return false
+			}
+		}
+		return true
+	}
+
+	if zone != "" {
+		resp := service.InstanceGroupManagers.ListManagedInstances(project, zone, group.Name).MaxResults(*pageSize)
+		err = resp.Pages(ctx, func(page *compute.InstanceGroupManagersListManagedInstancesResponse) error {
+			if !streamInstances(page.ManagedInstances) {
+				page.NextPageToken = ""
+			}
+			return nil
+		})
+	} else {
+		resp := service.RegionInstanceGroupManagers.ListManagedInstances(project, region, group.Name).MaxResults(*pageSize)
+		err = resp.Pages(ctx, func(page *compute.RegionInstanceGroupManagersListInstancesResponse) error {
+			if !streamInstances(page.ManagedInstances) {
+				page.NextPageToken = ""
+			}
+			return nil
+		})
+	}
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_compute_instance_group_managed_instance.listComputeInstanceGroupManagedInstances", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// listComputeInstanceGroupManagerErrors returns the recent errors of a zonal or
+// regional managed instance group, keyed by the URL of the instance
+func listComputeInstanceGroupManagerErrors(ctx context.Context, service *compute.Service, group *compute.InstanceGroupManager) (map[string][]*compute.InstanceManagedByIgmError, error) {
+	project := strings.Split(group.SelfLink, "/")[6]
+	zone := getLastPathElement(group.Zone)
+	region := getLastPathElement(group.Region)
+
+	instanceErrors := map[string][]*compute.InstanceManagedByIgmError{}
+	addErrors := func(items []*compute.InstanceManagedByIgmError) {
+		for _, item := range items {
+			if item.InstanceActionDetails == nil {
+				continue
+			}
+			instanceErrors[item.InstanceActionDetails.Instance] = append(instanceErrors[item.InstanceActionDetails.Instance], item)
+		}
+	}
+
+	var err error
+	if zone != "" {
+		err = service.InstanceGroupManagers.ListErrors(project, zone, group.Name).Pages(ctx, func(page *compute.InstanceGroupManagersListErrorsResponse) error {
+			addErrors(page.Items)
+			return nil
+		})
+	} else {
+		err = service.RegionInstanceGroupManagers.ListErrors(project, region, group.Name).Pages(ctx, func(page *compute.RegionInstanceGroupManagersListErrorsResponse) error {
+			addErrors(page.Items)
+			return nil
+		})
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return instanceErrors, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func computeInstanceGroupManagedInstanceHealthState(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	data := d.HydrateItem.(instanceGroupManagedInstanceInfo)
+
+	// A group has at most one autohealing health check
+	for _, health := range data.ManagedInstance.InstanceHealth {
+		if health.DetailedHealthState != "" {
+			return health.DetailedHealthState, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func computeInstanceGroupManagedInstanceIsUpToDate(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	data := d.HydrateItem.(instanceGroupManagedInstanceInfo)
+
+	if data.ManagedInstance.Version == nil {
+		return nil, nil
+	}
+
+	templates := []string{data.InstanceGroupManager.InstanceTemplate}
+	for _, version := range data.InstanceGroupManager.Versions {
+		templates = append(templates, version.InstanceTemplate)
+	}
+
+	return slices.Contains(templates, data.ManagedInstance.Version.InstanceTemplate), nil
+}
+
+func computeInstanceGroupManagedInstanceLocation(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	data := d.HydrateItem.(instanceGroupManagedInstanceInfo)
+
+	if data.InstanceGroupManager.Zone != "" {
+		return getLastPathElement(data.InstanceGroupManager.Zone), nil
+	}
+	return getLastPathElement(data.InstanceGroupManager.Region), nil
+}
+
+func computeInstanceGroupManagedInstanceProject(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	return strings.Split(types.SafeString(d.Value), "/")[6], nil
+}
@@ -0,0 +1,145 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+type computeInstanceGuestAttributeInfo = struct {
+	InstanceName string
+	ZoneName     string
+	QueryPath    string
+	Namespace    string
+	Key          string
+	Value        string
+	Project      string
+}
+
+//// TABLE DEFINITION
+
+func tableGcpComputeInstanceGuestAttribute(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_compute_instance_guest_attribute",
+		Description: "GCP Compute Instance Guest Attribute",
+		List: &plugin.ListConfig{
+			Hydrate: listComputeInstanceGuestAttributes,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "instance_name", Require: plugin.Required},
+				{Name: "zone_name", Require: plugin.Required},
+				{Name: "query_path", Require: plugin.Optional},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "instance_name",
+				Description: "The name of the instance.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "zone_name",
+				Description: "The name of the zone in which the instance resides.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "query_path",
+				Description: "The path that was queried, e.g. hostkeys/ to return the host keys namespace. All guest attributes are returned if not specified.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "namespace",
+				Description: "The namespace of the guest attribute.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "key",
+				Description: "The key of the guest attribute.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "value",
+				Description: "The value of the guest attribute.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Key"),
+			},
+
+			// standard gcp columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ZoneName"),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listComputeInstanceGuestAttributes(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	instanceName := d.EqualsQualString("instance_name")
+	zoneName := d.EqualsQualString("zone_name")
+	queryPath := d.EqualsQualString("query_path")
+
+	// Empty check
+	if instanceName == "" || zoneName == "" {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := ComputeService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_compute_instance_guest_attribute.listComputeInstanceGuestAttributes", "service_error", err)
+		return nil, err
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	call := service.Instances.GetGuestAttributes(project, zoneName, instanceName)
+	if queryPath != "" {
+		call = call.QueryPath(queryPath)
+	}
+
+	// The API returns 404 if guest attributes are not enabled on the instance,
+	// which is ignored by the plugin default ignore config
+	resp, err := call.Do()
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_compute_instance_guest_attribute.listComputeInstanceGuestAttributes", "api_error", err)
+		return nil, err
+	}
+
+	if resp.QueryValue == nil {
+		return nil, nil
+	}
+
+	for _, item := range resp.QueryValue.Items {
+		d.StreamListItem(ctx, computeInstanceGuestAttributeInfo{instanceName, zoneName, queryPath, item.Namespace, item.Key, item.Value, project})
+
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
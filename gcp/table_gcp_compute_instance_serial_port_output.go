@@ -0,0 +1,157 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"google.golang.org/api/compute/v1"
+)
+
+type computeInstanceSerialPortOutputInfo = struct {
+	Output       *compute.SerialPortOutput
+	InstanceName string
+	ZoneName     string
+	Port         int64
+	Start        int64
+	Project      string
+}
+
+//// TABLE DEFINITION
+
+func tableGcpComputeInstanceSerialPortOutput(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_compute_instance_serial_port_output",
+		Description: "GCP Compute Instance Serial Port Output",
+		List: &plugin.ListConfig{
+			Hydrate: listComputeInstanceSerialPortOutputs,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "instance_name", Require: plugin.Required},
+				{Name: "zone_name", Require: plugin.Required},
+				{Name: "port", Require: plugin.Optional},
+				{Name: "start", Require: plugin.Optional},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "instance_name",
+				Description: "The name of the instance.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "zone_name",
+				Description: "The name of the zone in which the instance resides.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "port",
+				Description: "The serial port the output was read from, between 1 and 4. Defaults to 1.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "start",
+				Description: "The requested byte offset from which to return the output. Defaults to 0.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "content_start",
+				Description: "The byte offset of the first byte of the returned contents. This can be greater than the requested start if the older output is no longer buffered.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Output.Start"),
+			},
+			{
+				Name:        "next",
+				Description: "The byte offset to use as the start of the next request, to get the output written after this one.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Output.Next"),
+			},
+			{
+				Name:        "contents",
+				Description: "The contents of the console output.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Output.Contents"),
+			},
+			{
+				Name:        "kind",
+				Description: "Type of the resource. Always compute#serialPortOutput for serial port output.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Output.Kind"),
+			},
+			{
+				Name:        "self_link",
+				Description: "Server-defined URL for this resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Output.SelfLink"),
+			},
+
+			// standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("InstanceName"),
+			},
+
+			// standard gcp columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ZoneName"),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listComputeInstanceSerialPortOutputs(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	instanceName := d.EqualsQualString("instance_name")
+	zoneName := d.EqualsQualString("zone_name")
+
+	// Empty check
+	if instanceName == "" || zoneName == "" {
+		return nil, nil
+	}
+
+	port := int64(1)
+	if d.EqualsQuals["port"] != nil {
+		port = d.EqualsQuals["port"].GetInt64Value()
+	}
+
+	start := int64(0)
+	if d.EqualsQuals["start"] != nil {
+		start = d.EqualsQuals["start"].GetInt64Value()
+	}
+
+	// Create Service Connection
+	service, err := ComputeService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_compute_instance_serial_port_output.listComputeInstanceSerialPortOutputs", "service_error", err)
+		return nil, err
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	resp, err := service.Instances.GetSerialPortOutput(project, zoneName, instanceName).Port(port).Start(start).Do()
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_compute_instance_serial_port_output.listComputeInstanceSerialPortOutputs", "api_error", err)
+		return nil, err
+	}
+
+	d.StreamListItem(ctx, computeInstanceSerialPortOutputInfo{resp, instanceName, zoneName, port, start, project})
+
+	return nil, nil
+}
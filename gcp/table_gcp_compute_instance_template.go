@@ -17,8 +17,11 @@ func tableGcpComputeInstanceTemplate(ctx context.Context) *plugin.Table {
 		Name:        "gcp_compute_instance_template",
 		Description: "GCP Compute Instance Template",
 		Get: &plugin.GetConfig{
-			KeyColumns: plugin.SingleColumn("name"),
-			Hydrate:    getComputeInstanceTemplate,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "name", Require: plugin.Required},
+				{Name: "location", Require: plugin.Optional},
+			},
+			Hydrate: getComputeInstanceTemplate,
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listComputeInstanceTemplate,
@@ -44,6 +47,19 @@ func tableGcpComputeInstanceTemplate(ctx context.Context) *plugin.Table {
 				Description: "The server-defined URL for this instance template.",
 				Type:        proto.ColumnType_STRING,
 			},
+			{
+				Name:        "region",
+				Description: "The URL of the region where the instance template resides. Only applicable for regional resources.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// region_name is a simpler view of the region, without the full path
+			{
+				Name:        "region_name",
+				Description: "The name of the region where the instance template resides. Only applicable for regional resources.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Region").Transform(lastPathElement).NullIfZero(),
+			},
 			{
 				Name:        "kind",
 				Description: "The resource type, which is always compute#instanceTemplate for instance templates.",
@@ -114,6 +130,48 @@ func tableGcpComputeInstanceTemplate(ctx context.Context) *plugin.Table {
 				Type:        proto.ColumnType_JSON,
 				Transform:   transform.FromField("Properties.Metadata"),
 			},
+			{
+				Name:        "effective_metadata",
+				Description: "The metadata key/value pairs that apply to the instances created from this template, which are the project-wide metadata merged with the template metadata. Template values override project values, and project-wide SSH keys are combined with the template SSH keys unless they are blocked.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getComputeInstanceTemplateEffectiveMetadata,
+				Transform:   transform.FromField("Metadata"),
+			},
+			{
+				Name:        "os_login_enabled",
+				Description: "True if OS Login is enabled for the instances created from this template, either in the template or the project-wide metadata.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     getComputeInstanceTemplateEffectiveMetadata,
+				Transform:   transform.FromField("OsLoginEnabled"),
+			},
+			{
+				Name:        "os_login_2fa_enabled",
+				Description: "True if OS Login with 2-step verification is enabled for the instances created from this template.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     getComputeInstanceTemplateEffectiveMetadata,
+				Transform:   transform.FromField("OsLogin2faEnabled"),
+			},
+			{
+				Name:        "serial_port_enabled",
+				Description: "True if interactive serial console access is enabled for the instances created from this template with the serial-port-enable metadata key.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     getComputeInstanceTemplateEffectiveMetadata,
+				Transform:   transform.FromField("SerialPortEnabled"),
+			},
+			{
+				Name:        "block_project_ssh_keys",
+				Description: "True if project-wide SSH keys are blocked for the instances created from this template, either with the block-project-ssh-keys metadata key or the legacy sshKeys metadata key.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     getComputeInstanceTemplateEffectiveMetadata,
+				Transform:   transform.FromField("BlockProjectSshKeys"),
+			},
+			{
+				Name:        "has_startup_script",
+				Description: "True if a startup script is set for the instances created from this template, with the startup-script, startup-script-url or windows-startup-script-ps1 metadata keys.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     getComputeInstanceTemplateEffectiveMetadata,
+				Transform:   transform.FromField("HasStartupScript"),
+			},
 			{
 				Name:        "instance_network_interfaces",
 				Description: "An array of network access configurations for this interface.",
@@ -188,7 +246,7 @@ func tableGcpComputeInstanceTemplate(ctx context.Context) *plugin.Table {
 				Name:        "location",
 				Description: ColumnDescriptionLocation,
 				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromConstant("global"),
+				Transform:   transform.FromP(gcpComputeInstanceTemplateTurbotData, "Location"),
 			},
 			{
 				Name:        "project",
@@ -210,7 +268,7 @@ func listComputeInstanceTemplate(ctx context.Context, d *plugin.QueryData, h *pl
 	}
 
 	// Max limit is set as per documentation
-	// https://pkg.go.dev/google.golang.org/api@v0.48.0/compute/v1?utm_source=gopls#InstanceTemplatesListCall.MaxResults
+	// https://pkg.go.dev/google.golang.org/api@v0.214.0/compute/v1#InstanceTemplatesAggregatedListCall.MaxResults
 	pageSize := types.Int64(500)
 	limit := d.QueryContext.Limit
 	if d.QueryContext.Limit != nil {
@@ -227,16 +285,19 @@ func listComputeInstanceTemplate(ctx context.Context, d *plugin.QueryData, h *pl
 	}
 	project := projectId.(string)
 
-	resp := service.InstanceTemplates.List(project).MaxResults(*pageSize)
-	if err := resp.Pages(ctx, func(page *compute.InstanceTemplateList) error {
-		for _, template := range page.Items {
-			d.StreamListItem(ctx, template)
+	// The aggregated list returns both the global and the regional instance templates
+	resp := service.InstanceTemplates.AggregatedList(project).MaxResults(*pageSize)
+	if err := resp.Pages(ctx, func(page *compute.InstanceTemplateAggregatedList) error {
+		for _, item := range page.Items {
+			for _, template := range item.InstanceTemplates {
+				d.StreamListItem(ctx, template)
 
-			// Check if context has been cancelled or if the limit has been hit (if specified)
-			// if there is a limit, it will return the number of rows required to reach this limit
-			if d.RowsRemaining(ctx) == 0 {
-				page.NextPageToken = ""
-				return nil
+				// Check if context has been cancelled or if the limit has been hit (if specified)
+				// if there is a limit, it will return the number of rows required to reach this limit
+				if d.RowsRemaining(ctx) == 0 {
+					page.NextPageToken = ""
+					return nil
+				}
 			}
 		}
 		return nil
@@ -272,12 +333,60 @@ func getComputeInstanceTemplate(ctx context.Context, d *plugin.QueryData, h *plu
 		return nil, nil
 	}
 
-	resp, err := service.InstanceTemplates.Get(project, name).Do()
+	// The template of the given location, either global or a region
+	if location := d.EqualsQualString("location"); location != "" {
+		var template *compute.InstanceTemplate
+		if location == "global" {
+			template, err = service.InstanceTemplates.Get(project, name).Do()
+		} else {
+			template, err = service.RegionInstanceTemplates.Get(project, location, name).Do()
+		}
+		if err != nil {
+			return nil, err
+		}
+		return template, nil
+	}
+
+	// Templates with the same name can exist globally and in several regions, in
+	// which case the global template is returned, unless the location is given
+	var template *compute.InstanceTemplate
+	resp := service.InstanceTemplates.AggregatedList(project).Filter("name=" + name)
+	if err := resp.Pages(ctx, func(page *compute.InstanceTemplateAggregatedList) error {
+		for _, item := range page.Items {
+			for _, t := range item.InstanceTemplates {
+				if template == nil || t.Region == "" {
+					template = t
+				}
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	// If the specified resource is not present, API does not return any not found errors
+	if template == nil {
+		return nil, nil
+	}
+
+	return template, nil
+}
+
+func getComputeInstanceTemplateEffectiveMetadata(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	instanceTemplate := h.Item.(*compute.InstanceTemplate)
+
+	projectMetadata, err := getComputeProjectCommonInstanceMetadata(ctx, d, h)
 	if err != nil {
+		plugin.Logger(ctx).Error("gcp_compute_instance_template.getComputeInstanceTemplateEffectiveMetadata", "api_error", err)
 		return nil, err
 	}
 
-	return resp, nil
+	var metadata *compute.Metadata
+	if instanceTemplate.Properties != nil {
+		metadata = instanceTemplate.Properties.Metadata
+	}
+
+	return computeEffectiveMetadata(projectMetadata.(*compute.Metadata), metadata), nil
 }
 
 //// TRANSFORM FUNCTION
@@ -289,8 +398,16 @@ func gcpComputeInstanceTemplateTurbotData(_ context.Context, d *transform.Transf
 	project := strings.Split(instanceTemplate.SelfLink, "/")[6]
 
 	turbotData := map[string]interface{}{
-		"Project": project,
-		"Akas":    []string{"gcp://compute.googleapis.com/projects/" + project + "/global/instanceTemplates/" + instanceTemplate.Name},
+		"Project":  project,
+		"Location": "global",
+		"Akas":     []string{"gcp://compute.googleapis.com/projects/" + project + "/global/instanceTemplates/" + instanceTemplate.Name},
+	}
+
+	// Regional instance templates
+	if instanceTemplate.Region != "" {
+		region := getLastPathElement(instanceTemplate.Region)
+		turbotData["Location"] = region
+		turbotData["Akas"] = []string{"gcp://compute.googleapis.com/projects/" + project + "/regions/" + region + "/instanceTemplates/" + instanceTemplate.Name}
 	}
 
 	return turbotData[param], nil
@@ -0,0 +1,359 @@
+package gcp
+
+import (
+	"context"
+	"strings"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"google.golang.org/api/compute/v1"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpComputeInterconnect(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_compute_interconnect",
+		Description: "GCP Compute Interconnect",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			Hydrate:    getComputeInterconnect,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listComputeInterconnects,
+			KeyColumns: plugin.KeyColumnSlice{
+				// String columns
+				{Name: "interconnect_type", Require: plugin.Optional, Operators: []string{"<>", "="}},
+				{Name: "operational_status", Require: plugin.Optional, Operators: []string{"<>", "="}},
+				{Name: "state", Require: plugin.Optional, Operators: []string{"<>", "="}},
+				// Boolean columns
+				{Name: "admin_enabled", Require: plugin.Optional, Operators: []string{"<>", "="}},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "Name of the resource. Provided by the client when the resource is created.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The unique identifier for the resource. This identifier is defined by the server.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "interconnect_type",
+				Description: "Type of interconnect, either PARTNER or DEDICATED.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "link_type",
+				Description: "Type of link requested, such as LINK_TYPE_ETHERNET_10G_LR or LINK_TYPE_ETHERNET_100G_LR.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "operational_status",
+				Description: "The current status of this Interconnect's functionality, either OS_ACTIVE or OS_UNPROVISIONED.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state",
+				Description: "The current state of Interconnect functionality, either ACTIVE or UNPROVISIONED.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "admin_enabled",
+				Description: "Administrative status of the interconnect. When this is set to true, the Interconnect is functional and can carry traffic.",
+				Type:        proto.ColumnType_BOOL,
+				Default:     false,
+			},
+			{
+				Name:        "creation_timestamp",
+				Description: "Creation timestamp in RFC3339 text format.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "description",
+				Description: "An optional description of this resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "kind",
+				Description: "Type of the resource. Always compute#interconnect for interconnects.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "customer_name",
+				Description: "Customer name, to put in the Letter of Authorization as the party authorized to request a crossconnect.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "interconnect_location",
+				Description: "URL of the InterconnectLocation object that represents where this connection is to be provisioned.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Location"),
+			},
+			{
+				Name:        "remote_location",
+				Description: "Indicates that this is a Cross-Cloud Interconnect. This field specifies the location outside of Google's network that the interconnect is connected to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "google_ip_address",
+				Description: "IP address configured on the Google side of the Interconnect link.",
+				Type:        proto.ColumnType_IPADDR,
+			},
+			{
+				Name:        "peer_ip_address",
+				Description: "IP address configured on the customer side of the Interconnect link.",
+				Type:        proto.ColumnType_IPADDR,
+			},
+			{
+				Name:        "google_reference_id",
+				Description: "Google reference ID to be used when raising support tickets with Google or otherwise to debug backend connectivity issues.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "noc_contact_email",
+				Description: "Email address to contact the customer NOC for operations and maintenance notifications regarding this Interconnect.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "requested_link_count",
+				Description: "Target number of physical links in the link bundle, as requested by the customer.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "provisioned_link_count",
+				Description: "Number of links actually provisioned in this interconnect.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "macsec_enabled",
+				Description: "Enable or disable MACsec on this Interconnect connection.",
+				Type:        proto.ColumnType_BOOL,
+				Default:     false,
+			},
+			{
+				Name:        "satisfies_pzs",
+				Description: "Reserved for future use.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "self_link",
+				Description: "Server-defined URL for the resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "available_features",
+				Description: "Features supported by this Interconnect connection, such as IF_MACSEC.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "circuit_infos",
+				Description: "A list of CircuitInfo objects, that describe the individual circuits in this LAG.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "diagnostics",
+				Description: "The diagnostics of the interconnect, including the ARP caches, LACP status and optical light levels of each link.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getComputeInterconnectDiagnostics,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "expected_outages",
+				Description: "A list of outages expected for this Interconnect.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "interconnect_attachments",
+				Description: "A list of the URLs of all InterconnectAttachments configured to use this Interconnect.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "labels",
+				Description: "Labels for this resource.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "macsec",
+				Description: "Configuration that enables Media Access Control security (MACsec) on the Interconnect connection between Google and your on-premises router.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "requested_features",
+				Description: "Optional features requested for this Interconnect connection, such as IF_MACSEC.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Labels"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromP(computeInterconnectTurbotData, "Akas"),
+			},
+
+			// standard gcp columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromConstant("global"),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(computeInterconnectTurbotData, "Project"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listComputeInterconnects(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("listComputeInterconnects")
+
+	// Create Service Connection
+	service, err := ComputeService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	filterQuals := []filterQualMap{
+		{"interconnect_type", "interconnectType", "string"},
+		{"operational_status", "operationalStatus", "string"},
+		{"state", "state", "string"},
+		{"admin_enabled", "adminEnabled", "boolean"},
+	}
+
+	filters := buildQueryFilterFromQuals(filterQuals, d.Quals)
+	filterString := ""
+	if len(filters) > 0 {
+		filterString = strings.Join(filters, " ")
+	}
+
+	// Max limit is set as per documentation
+	// https://pkg.go.dev/google.golang.org/api@v0.214.0/compute/v1#InterconnectsListCall.MaxResults
+	pageSize := types.Int64(500)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	// Get project details
+
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	resp := service.Interconnects.List(project).Filter(filterString).MaxResults(*pageSize)
+	if err := resp.Pages(ctx, func(page *compute.InterconnectList) error {
+		for _, interconnect := range page.Items {
+			d.StreamListItem(ctx, interconnect)
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				return nil
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getComputeInterconnect(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getComputeInterconnect")
+
+	// Create Service Connection
+	service, err := ComputeService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get project details
+
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+	name := d.EqualsQuals["name"].GetStringValue()
+
+	// Empty check
+	if len(name) < 1 {
+		return nil, nil
+	}
+
+	resp, err := service.Interconnects.Get(project, name).Do()
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func getComputeInterconnectDiagnostics(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	interconnect := h.Item.(*compute.Interconnect)
+	project := strings.Split(interconnect.SelfLink, "/")[6]
+
+	// Create Service Connection
+	service, err := ComputeService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_compute_interconnect.getComputeInterconnectDiagnostics", "service_error", err)
+		return nil, err
+	}
+
+	resp, err := service.Interconnects.GetDiagnostics(project, interconnect.Name).Do()
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_compute_interconnect.getComputeInterconnectDiagnostics", "api_error", err)
+		return nil, err
+	}
+
+	return resp.Result, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func computeInterconnectTurbotData(ctx context.Context, d *transform.TransformData) (interface{}, error) {
+	data := d.HydrateItem.(*compute.Interconnect)
+	param := d.Param.(string)
+
+	project := strings.Split(data.SelfLink, "/")[6]
+
+	turbotData := map[string]interface{}{
+		"Project": project,
+		"Akas":    []string{"gcp://compute.googleapis.com/projects/" + project + "/global/interconnects/" + data.Name},
+	}
+
+	return turbotData[param], nil
+}
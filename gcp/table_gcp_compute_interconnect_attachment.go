@@ -0,0 +1,376 @@
+package gcp
+
+import (
+	"context"
+	"strings"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"google.golang.org/api/compute/v1"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpComputeInterconnectAttachment(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_compute_interconnect_attachment",
+		Description: "GCP Compute Interconnect Attachment",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			Hydrate:    getComputeInterconnectAttachment,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listComputeInterconnectAttachments,
+			KeyColumns: plugin.KeyColumnSlice{
+				// String columns
+				{Name: "type", Require: plugin.Optional, Operators: []string{"<>", "="}},
+				{Name: "encryption", Require: plugin.Optional, Operators: []string{"<>", "="}},
+				{Name: "operational_status", Require: plugin.Optional, Operators: []string{"<>", "="}},
+				{Name: "state", Require: plugin.Optional, Operators: []string{"<>", "="}},
+				// Boolean columns
+				{Name: "admin_enabled", Require: plugin.Optional, Operators: []string{"<>", "="}},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "Name of the resource. Provided by the client when the resource is created.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The unique identifier for the resource. This identifier is defined by the server.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "type",
+				Description: "The type of interconnect attachment, such as DEDICATED, PARTNER or PARTNER_PROVIDER.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "operational_status",
+				Description: "The current status of whether or not this interconnect attachment is functional, either OS_ACTIVE or OS_UNPROVISIONED.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state",
+				Description: "The current state of this attachment's functionality, such as ACTIVE, PENDING_PARTNER, PARTNER_REQUEST_RECEIVED or UNPROVISIONED.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "admin_enabled",
+				Description: "Determines whether this Attachment will carry packets.",
+				Type:        proto.ColumnType_BOOL,
+				Default:     false,
+			},
+			{
+				Name:        "creation_timestamp",
+				Description: "Creation timestamp in RFC3339 text format.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "description",
+				Description: "An optional description of this resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "kind",
+				Description: "Type of the resource. Always compute#interconnectAttachment for interconnect attachments.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "interconnect",
+				Description: "URL of the underlying Interconnect object that this attachment's traffic will traverse through.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "router",
+				Description: "URL of the Cloud Router to be used for dynamic routing.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "vlan_tag_8021q",
+				Description: "The IEEE 802.1Q VLAN tag for this attachment, in the range 2-4093.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("VlanTag8021q"),
+			},
+			{
+				Name:        "bandwidth",
+				Description: "Provisioned bandwidth capacity for the interconnect attachment, such as BPS_1G or BPS_10G.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "encryption",
+				Description: "Indicates the user-supplied encryption option of this VLAN attachment, either NONE or IPSEC.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "edge_availability_domain",
+				Description: "Desired availability domain for the attachment, such as AVAILABILITY_DOMAIN_1 or AVAILABILITY_DOMAIN_ANY.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "mtu",
+				Description: "Maximum Transmission Unit (MTU), in bytes, of packets passing through this interconnect attachment.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "stack_type",
+				Description: "The stack type for this interconnect attachment, either IPV4_ONLY or IPV4_IPV6.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "cloud_router_ip_address",
+				Description: "IPv4 address + prefix length to be configured on Cloud Router Interface for this interconnect attachment.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "customer_router_ip_address",
+				Description: "IPv4 address + prefix length to be configured on the customer router subinterface for this interconnect attachment.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "cloud_router_ipv6_address",
+				Description: "IPv6 address + prefix length to be configured on Cloud Router Interface for this interconnect attachment.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "customer_router_ipv6_address",
+				Description: "IPv6 address + prefix length to be configured on the customer router subinterface for this interconnect attachment.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "google_reference_id",
+				Description: "Google reference ID, to be used when raising support tickets with Google or otherwise to debug backend connectivity issues.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "pairing_key",
+				Description: "The opaque identifier of a PARTNER attachment used to initiate provisioning with a selected partner.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "partner_asn",
+				Description: "Optional BGP ASN for the router supplied by a Layer 3 Partner if they configured BGP on behalf of the customer.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "remote_service",
+				Description: "If the attachment is on a Cross-Cloud Interconnect connection, this field contains the interconnect's remote location service provider.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "dataplane_version",
+				Description: "Dataplane version for this interconnect attachment.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "satisfies_pzs",
+				Description: "Reserved for future use.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "region",
+				Description: "URL of the region where the regional interconnect attachment resides.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "self_link",
+				Description: "Server-defined URL for the resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "candidate_subnets",
+				Description: "Up to 16 candidate prefixes that can be used to restrict the allocation of cloudRouterIpAddress and customerRouterIpAddress for this attachment.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "configuration_constraints",
+				Description: "Constraints for this attachment, if any. The attachment does not work if these constraints are not met.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "ipsec_internal_addresses",
+				Description: "A list of URLs of addresses that have been reserved for the VLAN attachment. Used only for the VLAN attachment that has the encryption option as IPSEC.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "labels",
+				Description: "Labels for this resource.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "partner_metadata",
+				Description: "Informational metadata about Partner attachments from Partners to display to customers.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "private_interconnect_info",
+				Description: "Information specific to an InterconnectAttachment. This property is populated if the interconnect that this is attached to is of type DEDICATED.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Labels"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromP(gcpComputeInterconnectAttachmentTurbotData, "Akas"),
+			},
+
+			// standard gcp columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(gcpComputeInterconnectAttachmentTurbotData, "Location"),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(gcpComputeInterconnectAttachmentTurbotData, "Project"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listComputeInterconnectAttachments(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("listComputeInterconnectAttachments")
+
+	// Create Service Connection
+	service, err := ComputeService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	filterQuals := []filterQualMap{
+		{"type", "type", "string"},
+		{"encryption", "encryption", "string"},
+		{"operational_status", "operationalStatus", "string"},
+		{"state", "state", "string"},
+		{"admin_enabled", "adminEnabled", "boolean"},
+	}
+
+	filters := buildQueryFilterFromQuals(filterQuals, d.Quals)
+	filterString := ""
+	if len(filters) > 0 {
+		filterString = strings.Join(filters, " ")
+	}
+
+	// Max limit is set as per documentation
+	// https://pkg.go.dev/google.golang.org/api@v0.214.0/compute/v1#InterconnectAttachmentsAggregatedListCall.MaxResults
+	pageSize := types.Int64(500)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	// Get project details
+
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	resp := service.InterconnectAttachments.AggregatedList(project).Filter(filterString).MaxResults(*pageSize)
+	if err := resp.Pages(ctx, func(page *compute.InterconnectAttachmentAggregatedList) error {
+		for _, item := range page.Items {
+			for _, interconnectAttachment := range item.InterconnectAttachments {
+				d.StreamListItem(ctx, interconnectAttachment)
+
+				// Check if context has been cancelled or if the limit has been hit (if specified)
+				// if there is a limit, it will return the number of rows required to reach this limit
+				if d.RowsRemaining(ctx) == 0 {
+					page.NextPageToken = ""
+					return nil
+				}
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getComputeInterconnectAttachment(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getComputeInterconnectAttachment")
+
+	// Create Service Connection
+	service, err := ComputeService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get project details
+
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+	name := d.EqualsQuals["name"].GetStringValue()
+
+	var interconnectAttachment compute.InterconnectAttachment
+	resp := service.InterconnectAttachments.AggregatedList(project).Filter("name=" + name)
+	if err := resp.Pages(ctx, func(page *compute.InterconnectAttachmentAggregatedList) error {
+		for _, item := range page.Items {
+			for _, i := range item.InterconnectAttachments {
+				interconnectAttachment = *i
+			}
+		}
+		return nil
+	},
+	); err != nil {
+		return nil, err
+	}
+
+	// If the specified resource is not present, API does not return any not found errors
+	if len(interconnectAttachment.Name) < 1 {
+		return nil, nil
+	}
+
+	return &interconnectAttachment, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func gcpComputeInterconnectAttachmentTurbotData(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	data := d.HydrateItem.(*compute.InterconnectAttachment)
+	param := d.Param.(string)
+
+	regionName := getLastPathElement(types.SafeString(data.Region))
+	project := strings.Split(data.SelfLink, "/")[6]
+
+	turbotData := map[string]interface{}{
+		"Location": regionName,
+		"Project":  project,
+		"Akas":     []string{"gcp://compute.googleapis.com/projects/" + project + "/regions/" + regionName + "/interconnectAttachments/" + data.Name},
+	}
+
+	return turbotData[param], nil
+}
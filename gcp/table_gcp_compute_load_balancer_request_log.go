@@ -0,0 +1,325 @@
+package gcp
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+// The monitored resource types of the request logs of the global external,
+// regional external and internal Application Load Balancers
+var computeLoadBalancerRequestLogResourceTypes = []string{"http_load_balancer", "http_external_regional_lb_rule", "internal_http_lb_rule"}
+
+//// TABLE DEFINITION
+
+func tableGcpComputeLoadBalancerRequestLog(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_compute_load_balancer_request_log",
+		Description: "GCP Compute Load Balancer Request Log",
+		List: &plugin.ListConfig{
+			Hydrate: listComputeLoadBalancerRequestLogs,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "remote_ip", Require: plugin.Optional},
+				{Name: "server_ip", Require: plugin.Optional},
+				{Name: "request_method", Require: plugin.Optional},
+				{Name: "status", Require: plugin.Optional, Operators: []string{"=", ">", "<", ">=", "<="}},
+				{Name: "status_details", Require: plugin.Optional},
+				{Name: "security_policy_name", Require: plugin.Optional},
+				{Name: "security_policy_outcome", Require: plugin.Optional},
+				{Name: "resource_type", Require: plugin.Optional},
+				{Name: "forwarding_rule_name", Require: plugin.Optional},
+				{Name: "url_map_name", Require: plugin.Optional},
+				{Name: "backend_service_name", Require: plugin.Optional},
+				{Name: "receive_timestamp", Require: plugin.Optional, Operators: []string{"=", ">", "<", ">=", "<="}},
+				{Name: "timestamp", Require: plugin.Optional, Operators: []string{"=", ">", "<", ">=", "<="}},
+				{Name: "filter", Require: plugin.Optional, CacheMatch: "exact"},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "insert_id",
+				Description: "A unique identifier for the log entry.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Entry.InsertId"),
+			},
+			{
+				Name:        "timestamp",
+				Description: "The time the request was received by the load balancer.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Entry.Timestamp"),
+				Sort:        plugin.SortAll,
+			},
+			{
+				Name:        "receive_timestamp",
+				Description: "The time the log entry was received by Logging.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Entry.ReceiveTimestamp"),
+			},
+			{
+				Name:        "severity",
+				Description: "The severity of the log entry, e.g. INFO, WARNING for 4xx responses or ERROR for 5xx responses.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Entry.Severity"),
+			},
+			{
+				Name:        "request_method",
+				Description: "The HTTP method of the request, e.g. GET or POST.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Entry.HttpRequest.RequestMethod"),
+			},
+			{
+				Name:        "request_url",
+				Description: "The scheme, host, path and query of the requested URL.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Entry.HttpRequest.RequestUrl"),
+			},
+			{
+				Name:        "status",
+				Description: "The HTTP status code of the response.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Entry.HttpRequest.Status"),
+			},
+			{
+				Name:        "status_details",
+				Description: "Why the load balancer returned the status, e.g. response_sent_by_backend or denied_by_security_policy.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Payload.statusDetails"),
+			},
+			{
+				Name:        "latency_seconds",
+				Description: "The time between the load balancer receiving the request and sending the last byte of the response, in seconds.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("Entry.HttpRequest.Latency").Transform(computeLoadBalancerRequestLogLatency),
+			},
+			{
+				Name:        "request_size",
+				Description: "The size of the request in bytes, including the headers and body.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Entry.HttpRequest.RequestSize"),
+			},
+			{
+				Name:        "response_size",
+				Description: "The size of the response in bytes, including the headers and body.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Entry.HttpRequest.ResponseSize"),
+			},
+			{
+				Name:        "remote_ip",
+				Description: "The IP address of the client that sent the request.",
+				Type:        proto.ColumnType_IPADDR,
+				Transform:   transform.FromField("Entry.HttpRequest.RemoteIp").NullIfZero(),
+			},
+			{
+				Name:        "server_ip",
+				Description: "The IP address of the backend that served the request.",
+				Type:        proto.ColumnType_IPADDR,
+				Transform:   transform.FromField("Entry.HttpRequest.ServerIp").NullIfZero(),
+			},
+			{
+				Name:        "user_agent",
+				Description: "The user agent of the client.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Entry.HttpRequest.UserAgent"),
+			},
+			{
+				Name:        "referer",
+				Description: "The referer URL of the request.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Entry.HttpRequest.Referer"),
+			},
+			{
+				Name:        "protocol",
+				Description: "The protocol of the request, e.g. HTTP/1.1 or HTTP/2.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Entry.HttpRequest.Protocol"),
+			},
+			{
+				Name:        "cache_hit",
+				Description: "True if the response was served from Cloud CDN.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Entry.HttpRequest.CacheHit"),
+			},
+			{
+				Name:        "security_policy_name",
+				Description: "The name of the Cloud Armor security policy enforced on the request.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Payload.enforcedSecurityPolicy.name"),
+			},
+			{
+				Name:        "security_policy_outcome",
+				Description: "The outcome of the enforced Cloud Armor security policy, either ACCEPT or DENY.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Payload.enforcedSecurityPolicy.outcome"),
+			},
+			{
+				Name:        "security_policy_action",
+				Description: "The action of the matched Cloud Armor rule, e.g. ALLOW, DENY, THROTTLE or RATE_BASED_BAN.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Payload.enforcedSecurityPolicy.configuredAction"),
+			},
+			{
+				Name:        "security_policy_priority",
+				Description: "The priority of the matched Cloud Armor rule.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Payload.enforcedSecurityPolicy.priority"),
+			},
+			{
+				Name:        "resource_type",
+				Description: "The monitored resource type of the load balancer, e.g. http_load_balancer for global external Application Load Balancers.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Entry.Resource.Type"),
+			},
+			{
+				Name:        "forwarding_rule_name",
+				Description: "The name of the forwarding rule that received the request.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Entry.Resource.Labels.forwarding_rule_name"),
+			},
+			{
+				Name:        "url_map_name",
+				Description: "The name of the URL map that routed the request.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Entry.Resource.Labels.url_map_name"),
+			},
+			{
+				Name:        "target_proxy_name",
+				Description: "The name of the target proxy of the load balancer.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Entry.Resource.Labels.target_proxy_name"),
+			},
+			{
+				Name:        "backend_service_name",
+				Description: "The name of the backend service that served the request.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Entry.Resource.Labels.backend_service_name"),
+			},
+			{
+				Name:        "filter",
+				Description: "An additional filter in the Logging query language, combined with the filter of the other columns.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("filter"),
+			},
+			{
+				Name:        "enforced_security_policy",
+				Description: "The Cloud Armor security policy enforced on the request, including the matched rule and any rate limiting or reCAPTCHA details.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Payload.enforcedSecurityPolicy"),
+			},
+			{
+				Name:        "preview_security_policy",
+				Description: "The Cloud Armor rule that would have matched the request if its preview mode was disabled.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Payload.previewSecurityPolicy"),
+			},
+			{
+				Name:        "http_request",
+				Description: "The full HTTP request details of the log entry.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Entry.HttpRequest"),
+			},
+			{
+				Name:        "resource",
+				Description: "The monitored resource of the load balancer, including all its labels.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Entry.Resource"),
+			},
+			{
+				Name:        "json_payload",
+				Description: "The full load balancer specific payload of the log entry.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Payload"),
+			},
+
+			// Standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Entry.InsertId"),
+			},
+
+			// Standard GCP columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(computeLoadBalancerRequestLogLocation),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getProject,
+				Transform:   transform.FromValue(),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listComputeLoadBalancerRequestLogs(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	filterQuals := []filterQualMap{
+		{"remote_ip", "httpRequest.remoteIp", "ip"},
+		{"server_ip", "httpRequest.serverIp", "ip"},
+		{"request_method", "httpRequest.requestMethod", "string"},
+		{"status", "httpRequest.status", "int"},
+		{"status_details", "jsonPayload.statusDetails", "string"},
+		{"security_policy_name", "jsonPayload.enforcedSecurityPolicy.name", "string"},
+		{"security_policy_outcome", "jsonPayload.enforcedSecurityPolicy.outcome", "string"},
+		{"resource_type", "resource.type", "string"},
+		{"forwarding_rule_name", "resource.labels.forwarding_rule_name", "string"},
+		{"url_map_name", "resource.labels.url_map_name", "string"},
+		{"backend_service_name", "resource.labels.backend_service_name", "string"},
+		{"receive_timestamp", "receiveTimestamp", "timestamp"},
+		{"timestamp", "timestamp", "timestamp"},
+	}
+
+	// Other services, e.g. Cloud Run, write request logs to logs of their own,
+	// but the resource type is restricted too for safety
+	var resourceTypes []string
+	for _, resourceType := range computeLoadBalancerRequestLogResourceTypes {
+		resourceTypes = append(resourceTypes, "\""+resourceType+"\"")
+	}
+	filters := []string{"resource.type = (" + strings.Join(resourceTypes, " OR ") + ")"}
+
+	logIds := []string{"requests"}
+	return nil, listLoggingEntriesOfLogs(ctx, d, h, logIds, filters, filterQuals, newLoggingEntryPayloadInfo)
+}
+
+//// TRANSFORM FUNCTIONS
+
+// computeLoadBalancerRequestLogLatency converts a latency in the Duration JSON
+// format, e.g. 0.012345s, to seconds
+func computeLoadBalancerRequestLogLatency(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	latency, ok := d.Value.(string)
+	if !ok || latency == "" {
+		return nil, nil
+	}
+
+	duration, err := time.ParseDuration(latency)
+	if err != nil {
+		return nil, err
+	}
+	return duration.Seconds(), nil
+}
+
+func computeLoadBalancerRequestLogLocation(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	data := d.HydrateItem.(loggingEntryPayloadInfo)
+	if data.Entry.Resource == nil {
+		return "global", nil
+	}
+
+	// Regional load balancers have a region label, global ones a global zone
+	if region := data.Entry.Resource.Labels["region"]; region != "" {
+		return region, nil
+	}
+	if zone := data.Entry.Resource.Labels["zone"]; zone != "" {
+		return zone, nil
+	}
+	return "global", nil
+}
@@ -0,0 +1,223 @@
+package gcp
+
+import (
+	"context"
+	"strings"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"google.golang.org/api/compute/v1"
+)
+
+type networkEndpointInfo = struct {
+	NetworkEndpoint      *compute.NetworkEndpointWithHealthStatus
+	NetworkEndpointGroup *compute.NetworkEndpointGroup
+}
+
+//// TABLE DEFINITION
+
+func tableGcpComputeNetworkEndpoint(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_compute_network_endpoint",
+		Description: "GCP Compute Network Endpoint",
+		List: &plugin.ListConfig{
+			Hydrate:       listComputeNetworkEndpoints,
+			ParentHydrate: listComputeNetworkEndpointGroups,
+			KeyColumns: plugin.KeyColumnSlice{
+				// String columns
+				{Name: "network_endpoint_group_name", Require: plugin.Optional},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "network_endpoint_group_name",
+				Description: "The name of the network endpoint group the endpoint belongs to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("NetworkEndpointGroup.Name"),
+			},
+			{
+				Name:        "network_endpoint_type",
+				Description: "Type of network endpoints in the network endpoint group.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("NetworkEndpointGroup.NetworkEndpointType"),
+			},
+			{
+				Name:        "instance",
+				Description: "The name or a URL of the VM instance that hosts the network endpoint.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("NetworkEndpoint.NetworkEndpoint.Instance"),
+			},
+			{
+				Name:        "ip_address",
+				Description: "The IPv4 address of the network endpoint.",
+				Type:        proto.ColumnType_IPADDR,
+				Transform:   transform.FromField("NetworkEndpoint.NetworkEndpoint.IpAddress").NullIfZero(),
+			},
+			{
+				Name:        "ipv6_address",
+				Description: "The IPv6 address of the network endpoint.",
+				Type:        proto.ColumnType_IPADDR,
+				Transform:   transform.FromField("NetworkEndpoint.NetworkEndpoint.Ipv6Address").NullIfZero(),
+			},
+			{
+				Name:        "port",
+				Description: "The port number of the network endpoint.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("NetworkEndpoint.NetworkEndpoint.Port"),
+			},
+			{
+				Name:        "fqdn",
+				Description: "The fully qualified domain name of the network endpoint, for INTERNET_FQDN_PORT network endpoint groups.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("NetworkEndpoint.NetworkEndpoint.Fqdn"),
+			},
+			{
+				Name:        "client_destination_port",
+				Description: "Represents the port number to which PSC consumer sends packets.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("NetworkEndpoint.NetworkEndpoint.ClientDestinationPort"),
+			},
+			{
+				Name:        "annotations",
+				Description: "Metadata defined as annotations on the network endpoint.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("NetworkEndpoint.NetworkEndpoint.Annotations"),
+			},
+			{
+				Name:        "healths",
+				Description: "The health states of the network endpoint, one per backend service and health check referencing the network endpoint group. Only available for zonal network endpoint groups.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("NetworkEndpoint.Healths"),
+			},
+			{
+				Name:        "network_endpoint_group_self_link",
+				Description: "Server-defined URL of the network endpoint group.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("NetworkEndpointGroup.SelfLink"),
+			},
+
+			// standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(gcpComputeNetworkEndpointTitle),
+			},
+
+			// standard gcp columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(gcpComputeNetworkEndpointLocation, "Location"),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(gcpComputeNetworkEndpointLocation, "Project"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listComputeNetworkEndpoints(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	networkEndpointGroup := h.Item.(*compute.NetworkEndpointGroup)
+
+	// Minimize the API calls with the given network endpoint group
+	groupName := d.EqualsQualString("network_endpoint_group_name")
+	if groupName != "" && groupName != networkEndpointGroup.Name {
+		return nil, nil
+	}
+
+	// Serverless network endpoint groups do not expose their endpoints
+	if networkEndpointGroup.NetworkEndpointType == "SERVERLESS" {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := ComputeService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_compute_network_endpoint.listComputeNetworkEndpoints", "connection_error", err)
+		return nil, err
+	}
+
+	// Max limit is set as per documentation
+	// https://pkg.go.dev/google.golang.org/api@v0.214.0/compute/v1#NetworkEndpointGroupsListNetworkEndpointsCall.MaxResults
+	pageSize := types.Int64(500)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	project := strings.Split(networkEndpointGroup.SelfLink, "/")[6]
+	zone := getLastPathElement(networkEndpointGroup.Zone)
+	region := getLastPathElement(networkEndpointGroup.Region)
+
+	streamEndpoints := func(page *compute.NetworkEndpointGroupsListNetworkEndpoints) error {
+		for _, networkEndpoint := range page.Items {
+			d.StreamLeafListItem(ctx, networkEndpointInfo{networkEndpoint, networkEndpointGroup})
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				return nil
+			}
+		}
+		return nil
+	}
+
+	switch {
+	case zone != "":
+		req := &compute.NetworkEndpointGroupsListEndpointsRequest{HealthStatus: "SHOW"}
+		err = service.NetworkEndpointGroups.ListNetworkEndpoints(project, zone, networkEndpointGroup.Name, req).MaxResults(*pageSize).Pages(ctx, streamEndpoints)
+	case region != "":
+		err = service.RegionNetworkEndpointGroups.ListNetworkEndpoints(project, region, networkEndpointGroup.Name).MaxResults(*pageSize).Pages(ctx, streamEndpoints)
+	default:
+		err = service.GlobalNetworkEndpointGroups.ListNetworkEndpoints(project, networkEndpointGroup.Name).MaxResults(*pageSize).Pages(ctx, streamEndpoints)
+	}
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_compute_network_endpoint.listComputeNetworkEndpoints", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func gcpComputeNetworkEndpointTitle(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	data := d.HydrateItem.(networkEndpointInfo)
+	endpoint := data.NetworkEndpoint.NetworkEndpoint
+
+	address := endpoint.IpAddress
+	if address == "" {
+		address = endpoint.Ipv6Address
+	}
+	if address == "" {
+		address = endpoint.Fqdn
+	}
+	if address == "" {
+		address = getLastPathElement(endpoint.Instance)
+	}
+	if endpoint.Port != 0 {
+		address = address + ":" + types.ToString(endpoint.Port)
+	}
+
+	return data.NetworkEndpointGroup.Name + "/" + address, nil
+}
+
+func gcpComputeNetworkEndpointLocation(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	data := d.HydrateItem.(networkEndpointInfo)
+	param := d.Param.(string)
+
+	return computeNetworkEndpointGroupLocationData(data.NetworkEndpointGroup)[param], nil
+}
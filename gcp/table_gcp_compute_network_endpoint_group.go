@@ -0,0 +1,337 @@
+package gcp
+
+import (
+	"context"
+	"strings"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"google.golang.org/api/compute/v1"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpComputeNetworkEndpointGroup(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_compute_network_endpoint_group",
+		Description: "GCP Compute Network Endpoint Group",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			Hydrate:    getComputeNetworkEndpointGroup,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listComputeNetworkEndpointGroups,
+			KeyColumns: plugin.KeyColumnSlice{
+				// String columns
+				{Name: "network_endpoint_type", Require: plugin.Optional, Operators: []string{"<>", "="}},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "Name of the resource. Provided by the client when the resource is created.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The unique identifier for the resource. This identifier is defined by the server.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "network_endpoint_type",
+				Description: "Type of network endpoints in this network endpoint group. Can be one of GCE_VM_IP, GCE_VM_IP_PORT, NON_GCP_PRIVATE_IP_PORT, INTERNET_FQDN_PORT, INTERNET_IP_PORT, SERVERLESS, PRIVATE_SERVICE_CONNECT or GCE_VM_IP_PORTMAP.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "creation_timestamp",
+				Description: "Creation timestamp in RFC3339 text format.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "description",
+				Description: "An optional description of this resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "kind",
+				Description: "Type of the resource. Always compute#networkEndpointGroup for network endpoint group.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "default_port",
+				Description: "The default port used if the port number is not specified in the network endpoint.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "size",
+				Description: "Number of network endpoints in the network endpoint group.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "network",
+				Description: "The URL of the network to which all network endpoints in the NEG belong.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "subnetwork",
+				Description: "Optional URL of the subnetwork to which all network endpoints in the NEG belong.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "psc_target_service",
+				Description: "The target service url used to set up private service connection to a Google API or a PSC Producer Service Attachment.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "zone",
+				Description: "The URL of the zone where the network endpoint group is located.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "region",
+				Description: "The URL of the region where the network endpoint group is located.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "self_link",
+				Description: "Server-defined URL for the resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "annotations",
+				Description: "Metadata defined as annotations on the network endpoint group.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "app_engine",
+				Description: "Only valid when networkEndpointType is SERVERLESS. Only one of cloudRun, appEngine or cloudFunction may be set.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "cloud_function",
+				Description: "Only valid when networkEndpointType is SERVERLESS. Only one of cloudRun, appEngine or cloudFunction may be set.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "cloud_run",
+				Description: "Only valid when networkEndpointType is SERVERLESS. Only one of cloudRun, appEngine or cloudFunction may be set.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "psc_data",
+				Description: "Private Service Connect data of the network endpoint group, such as the consumer PSC address and connection status.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "location_type",
+				Description: "Location type where the network endpoint group resides.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(gcpComputeNetworkEndpointGroupLocation, "Type"),
+			},
+
+			// standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromP(gcpComputeNetworkEndpointGroupLocation, "Akas"),
+			},
+
+			// standard gcp columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(gcpComputeNetworkEndpointGroupLocation, "Location"),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(gcpComputeNetworkEndpointGroupLocation, "Project"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listComputeNetworkEndpointGroups(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("listComputeNetworkEndpointGroups")
+
+	// Create Service Connection
+	service, err := ComputeService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	filterQuals := []filterQualMap{
+		{"network_endpoint_type", "networkEndpointType", "string"},
+	}
+
+	filters := buildQueryFilterFromQuals(filterQuals, d.Quals)
+	filterString := ""
+	if len(filters) > 0 {
+		filterString = strings.Join(filters, " ")
+	}
+
+	// Max limit is set as per documentation
+	// https://pkg.go.dev/google.golang.org/api@v0.214.0/compute/v1#NetworkEndpointGroupsAggregatedListCall.MaxResults
+	pageSize := types.Int64(500)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	// Get project details
+
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	// Zonal and regional network endpoint groups
+	seen := map[string]bool{}
+	resp := service.NetworkEndpointGroups.AggregatedList(project).Filter(filterString).MaxResults(*pageSize)
+	if err := resp.Pages(ctx, func(page *compute.NetworkEndpointGroupAggregatedList) error {
+		for _, item := range page.Items {
+			for _, networkEndpointGroup := range item.NetworkEndpointGroups {
+				seen[networkEndpointGroup.SelfLink] = true
+				d.StreamListItem(ctx, networkEndpointGroup)
+
+				// Check if context has been cancelled or if the limit has been hit (if specified)
+				// if there is a limit, it will return the number of rows required to reach this limit
+				if d.RowsRemaining(ctx) == 0 {
+					page.NextPageToken = ""
+					return nil
+				}
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if d.RowsRemaining(ctx) == 0 {
+		return nil, nil
+	}
+
+	// Global network endpoint groups, e.g. internet and PSC NEGs
+	globalResp := service.GlobalNetworkEndpointGroups.List(project).Filter(filterString).MaxResults(*pageSize)
+	if err := globalResp.Pages(ctx, func(page *compute.NetworkEndpointGroupList) error {
+		for _, networkEndpointGroup := range page.Items {
+			if seen[networkEndpointGroup.SelfLink] {
+				continue
+			}
+			d.StreamListItem(ctx, networkEndpointGroup)
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				return nil
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getComputeNetworkEndpointGroup(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Create Service Connection
+	service, err := ComputeService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get project details
+
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	var networkEndpointGroup compute.NetworkEndpointGroup
+	name := d.EqualsQuals["name"].GetStringValue()
+
+	resp := service.NetworkEndpointGroups.AggregatedList(project).Filter("name=" + name)
+	if err := resp.Pages(
+		ctx,
+		func(page *compute.NetworkEndpointGroupAggregatedList) error {
+			for _, item := range page.Items {
+				for _, i := range item.NetworkEndpointGroups {
+					networkEndpointGroup = *i
+				}
+			}
+			return nil
+		},
+	); err != nil {
+		return nil, err
+	}
+
+	if len(networkEndpointGroup.Name) > 0 {
+		return &networkEndpointGroup, nil
+	}
+
+	// If the specified resource is not present in any zone or region, look for a global network endpoint group
+	globalNetworkEndpointGroup, err := service.GlobalNetworkEndpointGroups.Get(project, name).Do()
+	if err != nil {
+		return nil, err
+	}
+
+	return globalNetworkEndpointGroup, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func gcpComputeNetworkEndpointGroupLocation(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	networkEndpointGroup := d.HydrateItem.(*compute.NetworkEndpointGroup)
+	param := d.Param.(string)
+
+	return computeNetworkEndpointGroupLocationData(networkEndpointGroup)[param], nil
+}
+
+// computeNetworkEndpointGroupLocationData returns the location details of a
+// zonal, regional or global network endpoint group
+func computeNetworkEndpointGroupLocationData(networkEndpointGroup *compute.NetworkEndpointGroup) map[string]interface{} {
+	zoneName := getLastPathElement(types.SafeString(networkEndpointGroup.Zone))
+	regionName := getLastPathElement(types.SafeString(networkEndpointGroup.Region))
+	project := strings.Split(networkEndpointGroup.SelfLink, "/")[6]
+
+	locationData := map[string]interface{}{
+		"Type":     "ZONAL",
+		"Location": zoneName,
+		"Project":  project,
+		"Akas":     []string{"gcp://compute.googleapis.com/projects/" + project + "/zones/" + zoneName + "/networkEndpointGroups/" + networkEndpointGroup.Name},
+	}
+
+	if zoneName == "" && regionName != "" {
+		locationData["Type"] = "REGIONAL"
+		locationData["Location"] = regionName
+		locationData["Akas"] = []string{"gcp://compute.googleapis.com/projects/" + project + "/regions/" + regionName + "/networkEndpointGroups/" + networkEndpointGroup.Name}
+	} else if zoneName == "" {
+		locationData["Type"] = "GLOBAL"
+		locationData["Location"] = "global"
+		locationData["Akas"] = []string{"gcp://compute.googleapis.com/projects/" + project + "/global/networkEndpointGroups/" + networkEndpointGroup.Name}
+	}
+
+	return locationData
+}
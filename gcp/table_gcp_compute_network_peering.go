@@ -0,0 +1,193 @@
+package gcp
+
+import (
+	"context"
+	"strings"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"google.golang.org/api/compute/v1"
+)
+
+type networkPeeringInfo = struct {
+	Peering *compute.NetworkPeering
+	Network *compute.Network
+}
+
+//// TABLE DEFINITION
+
+func tableGcpComputeNetworkPeering(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_compute_network_peering",
+		Description: "GCP Compute Network Peering",
+		List: &plugin.ListConfig{
+			Hydrate:       listComputeNetworkPeerings,
+			ParentHydrate: listComputeNetworks,
+			KeyColumns: plugin.KeyColumnSlice{
+				// String columns
+				{Name: "network_name", Require: plugin.Optional},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "Name of this peering.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Peering.Name"),
+			},
+			{
+				Name:        "network_name",
+				Description: "The name of the local network the peering belongs to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Network.Name"),
+			},
+			{
+				Name:        "network",
+				Description: "The URL of the local network the peering belongs to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Network.SelfLink"),
+			},
+			{
+				Name:        "peer_network",
+				Description: "The URL of the peer network. It can be either full URL or partial URL.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Peering.Network"),
+			},
+			{
+				Name:        "peer_network_name",
+				Description: "The name of the peer network.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Peering.Network").Transform(lastPathElement),
+			},
+			{
+				Name:        "peer_project",
+				Description: "The project ID of the peer network.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Peering.Network").Transform(gcpComputeNetworkPeeringPeerProject),
+			},
+			{
+				Name:        "state",
+				Description: "State for the peering, either ACTIVE or INACTIVE. The peering is ACTIVE when there's a matching configuration in the peer network.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Peering.State"),
+			},
+			{
+				Name:        "state_details",
+				Description: "Details about the current state of the peering.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Peering.StateDetails"),
+			},
+			{
+				Name:        "stack_type",
+				Description: "Which IP version(s) of traffic and routes are allowed to be imported or exported between peer networks, either IPV4_ONLY or IPV4_IPV6.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Peering.StackType"),
+			},
+			{
+				Name:        "peer_mtu",
+				Description: "Maximum Transmission Unit in bytes of the peer network.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Peering.PeerMtu"),
+			},
+			{
+				Name:        "exchange_subnet_routes",
+				Description: "Indicates whether full mesh connectivity is created and managed automatically between peered networks.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Peering.ExchangeSubnetRoutes"),
+			},
+			{
+				Name:        "export_custom_routes",
+				Description: "Whether to export the custom routes to the peer network.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Peering.ExportCustomRoutes"),
+			},
+			{
+				Name:        "import_custom_routes",
+				Description: "Whether to import the custom routes from the peer network.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Peering.ImportCustomRoutes"),
+			},
+			{
+				Name:        "export_subnet_routes_with_public_ip",
+				Description: "Whether subnet routes with public IP range are exported.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Peering.ExportSubnetRoutesWithPublicIp"),
+			},
+			{
+				Name:        "import_subnet_routes_with_public_ip",
+				Description: "Whether subnet routes with public IP range are imported.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Peering.ImportSubnetRoutesWithPublicIp"),
+			},
+
+			// standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Peering.Name"),
+			},
+
+			// standard gcp columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromConstant("global"),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Network.SelfLink").Transform(gcpComputeNetworkPeeringPeerProject),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listComputeNetworkPeerings(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	network := h.Item.(*compute.Network)
+
+	// Minimize the processing with the given network
+	networkName := d.EqualsQualString("network_name")
+	if networkName != "" && networkName != network.Name {
+		return nil, nil
+	}
+
+	// Peerings are returned inline with the network
+	for _, peering := range network.Peerings {
+		d.StreamLeafListItem(ctx, networkPeeringInfo{peering, network})
+
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+// gcpComputeNetworkPeeringPeerProject extracts the project from a full or
+// partial network URL, e.g. projects/my-project/global/networks/my-network
+func gcpComputeNetworkPeeringPeerProject(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	networkUrl, ok := d.Value.(string)
+	if !ok || networkUrl == "" {
+		return nil, nil
+	}
+
+	splitUrl := strings.Split(networkUrl, "/")
+	for i, part := range splitUrl {
+		if part == "projects" && i+1 < len(splitUrl) {
+			return splitUrl[i+1], nil
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,224 @@
+package gcp
+
+import (
+	"context"
+	"strings"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"google.golang.org/api/compute/v1"
+)
+
+type nodeGroupNodeInfo = struct {
+	NodeGroup *compute.NodeGroup
+	Node      *compute.NodeGroupNode
+}
+
+//// TABLE DEFINITION
+
+func tableGcpComputeNodeGroupNode(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_compute_node_group_node",
+		Description: "GCP Compute Node Group Node",
+		List: &plugin.ListConfig{
+			Hydrate:       listComputeNodeGroupNodes,
+			ParentHydrate: listComputeNodeGroups,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "node_group_name", Require: plugin.Optional},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the node.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Node.Name"),
+			},
+			{
+				Name:        "node_group_name",
+				Description: "The name of the node group the node belongs to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("NodeGroup.Name"),
+			},
+			{
+				Name:        "node_group",
+				Description: "The URL of the node group the node belongs to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("NodeGroup.SelfLink"),
+			},
+			{
+				Name:        "status",
+				Description: "The status of the node, e.g. CREATING, READY, REPAIRING or DELETING.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Node.Status"),
+			},
+			{
+				Name:        "node_type",
+				Description: "The type of the node, e.g. n1-node-96-624.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Node.NodeType"),
+			},
+			{
+				Name:        "server_id",
+				Description: "The ID of the physical server the node is running on.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Node.ServerId"),
+			},
+			{
+				Name:        "cpu_overcommit_type",
+				Description: "The CPU overcommit type of the node, either ENABLED or NONE.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Node.CpuOvercommitType"),
+			},
+			{
+				Name:        "instance_count",
+				Description: "The number of instances running on the node.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.From(computeNodeGroupNodeInstanceCount),
+			},
+			{
+				Name:        "satisfies_pzs",
+				Description: "Reserved for future use.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Node.SatisfiesPzs"),
+			},
+			{
+				Name:        "accelerators",
+				Description: "The accelerators attached to the node.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Node.Accelerators"),
+			},
+			{
+				Name:        "consumed_resources",
+				Description: "The vCPUs, memory and local SSD consumed by the instances running on the node.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Node.ConsumedResources"),
+			},
+			{
+				Name:        "disks",
+				Description: "The local disks attached to the node.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Node.Disks"),
+			},
+			{
+				Name:        "instance_consumption_data",
+				Description: "The resources consumed by each instance running on the node.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Node.InstanceConsumptionData"),
+			},
+			{
+				Name:        "instances",
+				Description: "The URLs of the instances running on the node.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Node.Instances"),
+			},
+			{
+				Name:        "server_binding",
+				Description: "The server binding policy of the node, which determines whether instances are restarted on the same physical server.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Node.ServerBinding"),
+			},
+			{
+				Name:        "total_resources",
+				Description: "The total vCPUs, memory and local SSD of the node.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Node.TotalResources"),
+			},
+			{
+				Name:        "upcoming_maintenance",
+				Description: "The upcoming maintenance event of the node, if any.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Node.UpcomingMaintenance"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Node.Name"),
+			},
+
+			// GCP standard columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("NodeGroup.Zone").Transform(lastPathElement),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(computeNodeGroupNodeProject),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listComputeNodeGroupNodes(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	nodeGroup := h.Item.(*compute.NodeGroup)
+
+	// Minimize the API calls with the given node group
+	nodeGroupName := d.EqualsQualString("node_group_name")
+	if nodeGroupName != "" && nodeGroupName != nodeGroup.Name {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := ComputeService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_compute_node_group_node.listComputeNodeGroupNodes", "connection_error", err)
+		return nil, err
+	}
+
+	// Max limit is set as per documentation
+	// https://pkg.go.dev/google.golang.org/api@v0.214.0/compute/v1#NodeGroupsListNodesCall.MaxResults
+	pageSize := types.Int64(500)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	project := strings.Split(nodeGroup.SelfLink, "/")[6]
+	zone := getLastPathElement(nodeGroup.Zone)
+
+	resp := service.NodeGroups.ListNodes(project, zone, nodeGroup.Name).MaxResults(*pageSize)
+	if err := resp.Pages(ctx, func(page *compute.NodeGroupsListNodes) error {
+		for _, node := range page.Items {
+			d.StreamLeafListItem(ctx, nodeGroupNodeInfo{nodeGroup, node})
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				return nil
+			}
+		}
+		return nil
+	}); err != nil {
+		plugin.Logger(ctx).Error("gcp_compute_node_group_node.listComputeNodeGroupNodes", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func computeNodeGroupNodeInstanceCount(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	data := d.HydrateItem.(nodeGroupNodeInfo)
+	return len(data.Node.Instances), nil
+}
+
+func computeNodeGroupNodeProject(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	data := d.HydrateItem.(nodeGroupNodeInfo)
+	return strings.Split(data.NodeGroup.SelfLink, "/")[6], nil
+}
@@ -0,0 +1,192 @@
+package gcp
+
+import (
+	"context"
+	"math"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"google.golang.org/api/compute/v1"
+)
+
+type computeQuotaInfo = struct {
+	Quota    *compute.Quota
+	Location string
+	Project  string
+}
+
+//// TABLE DEFINITION
+
+func tableGcpComputeQuota(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_compute_quota",
+		Description: "GCP Compute Quota",
+		List: &plugin.ListConfig{
+			Hydrate: listComputeQuotas,
+			KeyColumns: plugin.KeyColumnSlice{
+				// String columns
+				{Name: "location", Require: plugin.Optional},
+				{Name: "metric", Require: plugin.Optional},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "metric",
+				Description: "The name of the quota metric, e.g. CPUS or IN_USE_ADDRESSES.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Quota.Metric"),
+			},
+			{
+				Name:        "limit",
+				Description: "The quota limit for this metric.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("Quota.Limit"),
+			},
+			{
+				Name:        "usage",
+				Description: "The current usage of this metric.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("Quota.Usage"),
+			},
+			{
+				Name:        "percent_used",
+				Description: "The percentage of the quota limit that is currently used. Null if the limit is zero or unlimited.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.From(computeQuotaPercentUsed),
+			},
+			{
+				Name:        "owner",
+				Description: "The owning resource, if the quota is shared with other resources.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Quota.Owner"),
+			},
+
+			// standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Quota.Metric"),
+			},
+
+			// standard gcp columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listComputeQuotas(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Create Service Connection
+	service, err := ComputeService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_compute_quota.listComputeQuotas", "service_error", err)
+		return nil, err
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	location := d.EqualsQualString("location")
+	metric := d.EqualsQualString("metric")
+
+	// Project-wide quotas are returned as global rows
+	if location == "" || location == "global" {
+		resp, err := service.Projects.Get(project).Do()
+		if err != nil {
+			plugin.Logger(ctx).Error("gcp_compute_quota.listComputeQuotas", "api_error", err)
+			return nil, err
+		}
+
+		if streamComputeQuotas(ctx, d, resp.Quotas, "global", project, metric) {
+			return nil, nil
+		}
+
+		// Minimize the API call with the given location
+		if location == "global" {
+			return nil, nil
+		}
+	}
+
+	regionFilter := ""
+	if location != "" {
+		regionFilter = "name=" + location
+	}
+
+	resp := service.Regions.List(project).Filter(regionFilter)
+	if err := resp.Pages(ctx, func(page *compute.RegionList) error {
+		for _, region := range page.Items {
+			quotas := region.Quotas
+
+			// The quotas field of a listed region is omitted if it could not be
+			// fetched, in which case the region is fetched individually
+			if region.QuotaStatusWarning != nil {
+				item, err := service.Regions.Get(project, region.Name).Do()
+				if err != nil {
+					return err
+				}
+				quotas = item.Quotas
+			}
+
+			if streamComputeQuotas(ctx, d, quotas, region.Name, project, metric) {
+				page.NextPageToken = ""
+				return nil
+			}
+		}
+		return nil
+	}); err != nil {
+		plugin.Logger(ctx).Error("gcp_compute_quota.listComputeQuotas", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// streamComputeQuotas streams a row per quota matching the given metric, and
+// reports whether the limit has been hit
+func streamComputeQuotas(ctx context.Context, d *plugin.QueryData, quotas []*compute.Quota, location string, project string, metric string) bool {
+	for _, quota := range quotas {
+		if metric != "" && metric != quota.Metric {
+			continue
+		}
+
+		d.StreamListItem(ctx, computeQuotaInfo{quota, location, project})
+
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+//// TRANSFORM FUNCTIONS
+
+func computeQuotaPercentUsed(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	data := d.HydrateItem.(computeQuotaInfo)
+
+	// Unlimited or zero quotas have no meaningful percentage
+	if data.Quota.Limit <= 0 {
+		return nil, nil
+	}
+
+	return math.Round(data.Quota.Usage/data.Quota.Limit*10000) / 100, nil
+}
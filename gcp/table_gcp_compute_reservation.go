@@ -0,0 +1,305 @@
+package gcp
+
+import (
+	"context"
+	"strings"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"google.golang.org/api/compute/v1"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpComputeReservation(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_compute_reservation",
+		Description: "GCP Compute Reservation",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			Hydrate:    getComputeReservation,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listComputeReservations,
+			KeyColumns: plugin.KeyColumnSlice{
+				// String columns
+				{Name: "status", Require: plugin.Optional, Operators: []string{"<>", "="}},
+				// Boolean columns
+				{Name: "specific_reservation_required", Require: plugin.Optional, Operators: []string{"<>", "="}},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "Name of the resource. Provided by the client when the resource is created.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The unique identifier for the resource. This identifier is defined by the server.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "status",
+				Description: "The status of the reservation, such as CREATING, READY, UPDATING or DELETING.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "creation_timestamp",
+				Description: "Creation timestamp in RFC3339 text format.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "description",
+				Description: "An optional description of this resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "kind",
+				Description: "Type of the resource. Always compute#reservations for reservations.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "specific_reservation_required",
+				Description: "Indicates whether the reservation can be consumed by VMs with affinity for any reservation, or only by VMs that target this reservation by name.",
+				Type:        proto.ColumnType_BOOL,
+				Default:     false,
+			},
+			{
+				Name:        "commitment",
+				Description: "The full URL of the commitment that this reservation is attached to, if any.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "machine_type",
+				Description: "The machine type of the instances reserved, e.g. n2-standard-4.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SpecificReservation.InstanceProperties.MachineType"),
+			},
+			{
+				Name:        "count",
+				Description: "The number of resources that are allocated by the specific SKU reservation.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("SpecificReservation.Count"),
+			},
+			{
+				Name:        "in_use_count",
+				Description: "The number of instances that are currently consuming the specific SKU reservation.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("SpecificReservation.InUseCount"),
+			},
+			{
+				Name:        "assured_count",
+				Description: "The number of resources that are assured to be available for the specific SKU reservation.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("SpecificReservation.AssuredCount"),
+			},
+			{
+				Name:        "share_type",
+				Description: "The type of sharing for the reservation, either LOCAL or SPECIFIC_PROJECTS.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ShareSettings.ShareType"),
+			},
+			{
+				Name:        "satisfies_pzs",
+				Description: "Reserved for future use.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			// zone_name is a simpler view of the zone, without the full path
+			{
+				Name:        "zone_name",
+				Description: "The zone name in which the reservation resides.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Zone").Transform(lastPathElement),
+			},
+			{
+				Name:        "zone",
+				Description: "The URL of the zone where the reservation resides.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "self_link",
+				Description: "Server-defined URL for the resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "aggregate_reservation",
+				Description: "The reservation for aggregated resources, providing shape flexibility.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "resource_policies",
+				Description: "The resource policies attached to the reservation, as a map of key to the URL of the resource policy.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "resource_status",
+				Description: "The status information of the reservation, such as the specific SKU allocation.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "share_settings",
+				Description: "The share settings of the reservation, including the projects it is shared with.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "specific_reservation",
+				Description: "The reservation for instances with specific machine shapes, including the reserved instance properties.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromP(gcpComputeReservationTurbotData, "Akas"),
+			},
+
+			// standard gcp columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(gcpComputeReservationTurbotData, "Location"),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(gcpComputeReservationTurbotData, "Project"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listComputeReservations(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("listComputeReservations")
+
+	// Create Service Connection
+	service, err := ComputeService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	filterQuals := []filterQualMap{
+		{"status", "status", "string"},
+		{"specific_reservation_required", "specificReservationRequired", "boolean"},
+	}
+
+	filters := buildQueryFilterFromQuals(filterQuals, d.Quals)
+	filterString := ""
+	if len(filters) > 0 {
+		filterString = strings.Join(filters, " ")
+	}
+
+	// Max limit is set as per documentation
+	// https://pkg.go.dev/google.golang.org/api@v0.214.0/compute/v1#ReservationsAggregatedListCall.MaxResults
+	pageSize := types.Int64(500)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	// Get project details
+
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	resp := service.Reservations.AggregatedList(project).Filter(filterString).MaxResults(*pageSize)
+	if err := resp.Pages(ctx, func(page *compute.ReservationAggregatedList) error {
+		for _, item := range page.Items {
+			for _, reservation := range item.Reservations {
+				d.StreamListItem(ctx, reservation)
+
+				// Check if context has been cancelled or if the limit has been hit (if specified)
+				// if there is a limit, it will return the number of rows required to reach this limit
+				if d.RowsRemaining(ctx) == 0 {
+					page.NextPageToken = ""
+					return nil
+				}
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getComputeReservation(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getComputeReservation")
+
+	// Create Service Connection
+	service, err := ComputeService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get project details
+
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+	name := d.EqualsQuals["name"].GetStringValue()
+
+	var reservation compute.Reservation
+	resp := service.Reservations.AggregatedList(project).Filter("name=" + name)
+	if err := resp.Pages(ctx, func(page *compute.ReservationAggregatedList) error {
+		for _, item := range page.Items {
+			for _, i := range item.Reservations {
+				reservation = *i
+			}
+		}
+		return nil
+	},
+	); err != nil {
+		return nil, err
+	}
+
+	// If the specified resource is not present, API does not return any not found errors
+	if len(reservation.Name) < 1 {
+		return nil, nil
+	}
+
+	return &reservation, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func gcpComputeReservationTurbotData(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	data := d.HydrateItem.(*compute.Reservation)
+	param := d.Param.(string)
+
+	zoneName := getLastPathElement(types.SafeString(data.Zone))
+	project := strings.Split(data.SelfLink, "/")[6]
+
+	turbotData := map[string]interface{}{
+		"Location": zoneName,
+		"Project":  project,
+		"Akas":     []string{"gcp://compute.googleapis.com/projects/" + project + "/zones/" + zoneName + "/reservations/" + data.Name},
+	}
+
+	return turbotData[param], nil
+}
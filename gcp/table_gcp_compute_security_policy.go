@@ -0,0 +1,312 @@
+package gcp
+
+import (
+	"context"
+	"strings"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"google.golang.org/api/compute/v1"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpComputeSecurityPolicy(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_compute_security_policy",
+		Description: "GCP Compute Security Policy",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			Hydrate:    getComputeSecurityPolicy,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listComputeSecurityPolicies,
+			KeyColumns: plugin.KeyColumnSlice{
+				// String columns
+				{Name: "type", Require: plugin.Optional, Operators: []string{"<>", "="}},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "Name of the resource. Provided by the client when the resource is created.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The unique identifier for the resource. This identifier is defined by the server.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "type",
+				Description: "The type indicates the intended use of the security policy. Can be CLOUD_ARMOR, CLOUD_ARMOR_EDGE or CLOUD_ARMOR_NETWORK.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "creation_timestamp",
+				Description: "Creation timestamp in RFC3339 text format.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "description",
+				Description: "An optional description of this resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "kind",
+				Description: "Type of the resource. Always compute#securityPolicy for security policies.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "fingerprint",
+				Description: "Specifies a fingerprint for this resource, which is essentially a hash of the metadata's contents and used for optimistic locking.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "adaptive_protection_enabled",
+				Description: "Indicates whether Adaptive Protection layer 7 DDoS defense is enabled for the security policy.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("AdaptiveProtectionConfig.Layer7DdosDefenseConfig.Enable"),
+				Default:     false,
+			},
+			{
+				Name:        "region",
+				Description: "The URL of the region where the regional security policy resides.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "self_link",
+				Description: "Server-defined URL for the resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "rule_count",
+				Description: "The number of rules in the security policy.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Rules").Transform(gcpComputeSecurityPolicyRuleCount),
+			},
+			{
+				Name:        "adaptive_protection_config",
+				Description: "The Adaptive Protection configuration of the security policy.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "advanced_options_config",
+				Description: "The advanced options configuration, such as JSON parsing and log level.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "ddos_protection_config",
+				Description: "The advanced network DDoS protection configuration.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "labels",
+				Description: "Labels for this resource.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "recaptcha_options_config",
+				Description: "The reCAPTCHA configuration of the security policy.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "rules",
+				Description: "A list of rules that belong to this policy.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "user_defined_fields",
+				Description: "Definitions of user-defined fields for CLOUD_ARMOR_NETWORK policies.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "location_type",
+				Description: "Location type where the security policy resides.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(gcpComputeSecurityPolicyLocation, "Type"),
+			},
+
+			// standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Labels"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromP(gcpComputeSecurityPolicyLocation, "Akas"),
+			},
+
+			// standard gcp columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(gcpComputeSecurityPolicyLocation, "Location"),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(gcpComputeSecurityPolicyLocation, "Project"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listComputeSecurityPolicies(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("listComputeSecurityPolicies")
+
+	// Create Service Connection
+	service, err := ComputeService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	filterQuals := []filterQualMap{
+		{"type", "type", "string"},
+	}
+
+	filters := buildQueryFilterFromQuals(filterQuals, d.Quals)
+	filterString := ""
+	if len(filters) > 0 {
+		filterString = strings.Join(filters, " ")
+	}
+
+	// Max limit is set as per documentation
+	// https://pkg.go.dev/google.golang.org/api@v0.214.0/compute/v1#SecurityPoliciesAggregatedListCall.MaxResults
+	pageSize := types.Int64(500)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	// Get project details
+
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	// Global, edge and regional security policies
+	resp := service.SecurityPolicies.AggregatedList(project).Filter(filterString).MaxResults(*pageSize)
+	if err := resp.Pages(ctx, func(page *compute.SecurityPoliciesAggregatedList) error {
+		for _, item := range page.Items {
+			for _, securityPolicy := range item.SecurityPolicies {
+				d.StreamListItem(ctx, securityPolicy)
+
+				// Check if context has been cancelled or if the limit has been hit (if specified)
+				// if there is a limit, it will return the number of rows required to reach this limit
+				if d.RowsRemaining(ctx) == 0 {
+					page.NextPageToken = ""
+					return nil
+				}
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getComputeSecurityPolicy(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Create Service Connection
+	service, err := ComputeService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get project details
+
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	var securityPolicy compute.SecurityPolicy
+	name := d.EqualsQuals["name"].GetStringValue()
+
+	resp := service.SecurityPolicies.AggregatedList(project).Filter("name=" + name)
+	if err := resp.Pages(
+		ctx,
+		func(page *compute.SecurityPoliciesAggregatedList) error {
+			for _, item := range page.Items {
+				for _, i := range item.SecurityPolicies {
+					securityPolicy = *i
+				}
+			}
+			return nil
+		},
+	); err != nil {
+		return nil, err
+	}
+
+	// If the specified resource is not present, API does not return any not found errors
+	if len(securityPolicy.Name) < 1 {
+		return nil, nil
+	}
+
+	return &securityPolicy, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func gcpComputeSecurityPolicyRuleCount(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	rules, ok := d.Value.([]*compute.SecurityPolicyRule)
+	if !ok {
+		return 0, nil
+	}
+	return len(rules), nil
+}
+
+func gcpComputeSecurityPolicyLocation(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	securityPolicy := d.HydrateItem.(*compute.SecurityPolicy)
+	param := d.Param.(string)
+
+	return computeSecurityPolicyLocationData(securityPolicy)[param], nil
+}
+
+// computeSecurityPolicyLocationData returns the location details of a global or
+// regional security policy
+func computeSecurityPolicyLocationData(securityPolicy *compute.SecurityPolicy) map[string]interface{} {
+	regionName := getLastPathElement(types.SafeString(securityPolicy.Region))
+	project := strings.Split(securityPolicy.SelfLink, "/")[6]
+
+	locationData := map[string]interface{}{
+		"Type":     "REGIONAL",
+		"Location": regionName,
+		"Project":  project,
+		"Akas":     []string{"gcp://compute.googleapis.com/projects/" + project + "/regions/" + regionName + "/securityPolicies/" + securityPolicy.Name},
+	}
+
+	if regionName == "" {
+		locationData["Type"] = "GLOBAL"
+		locationData["Location"] = "global"
+		locationData["Akas"] = []string{"gcp://compute.googleapis.com/projects/" + project + "/global/securityPolicies/" + securityPolicy.Name}
+	}
+
+	return locationData
+}
@@ -0,0 +1,235 @@
+package gcp
+
+import (
+	"context"
+	"regexp"
+	"strconv"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"google.golang.org/api/compute/v1"
+)
+
+type securityPolicyRuleInfo = struct {
+	Rule           *compute.SecurityPolicyRule
+	SecurityPolicy *compute.SecurityPolicy
+}
+
+// Matches the rule set names in preconfigured WAF expressions, e.g.
+// evaluatePreconfiguredWaf('sqli-v33-stable', {'sensitivity': 1}) or
+// evaluatePreconfiguredExpr('xss-stable')
+var preconfiguredWafRuleSetRegex = regexp.MustCompile(`evaluatePreconfigured(?:Waf|Expr)\(\s*['"]([^'"]+)['"]`)
+
+//// TABLE DEFINITION
+
+func tableGcpComputeSecurityPolicyRule(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_compute_security_policy_rule",
+		Description: "GCP Compute Security Policy Rule",
+		List: &plugin.ListConfig{
+			Hydrate:       listComputeSecurityPolicyRules,
+			ParentHydrate: listComputeSecurityPolicies,
+			KeyColumns: plugin.KeyColumnSlice{
+				// String columns
+				{Name: "security_policy_name", Require: plugin.Optional},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "security_policy_name",
+				Description: "The name of the security policy the rule belongs to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SecurityPolicy.Name"),
+			},
+			{
+				Name:        "priority",
+				Description: "An integer indicating the priority of a rule in the list. Rules are evaluated from highest to lowest priority, where 0 is the highest priority and 2147483647 is the lowest.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Rule.Priority"),
+			},
+			{
+				Name:        "action",
+				Description: "The action to take for requests that match the rule, such as allow, deny(403), redirect, rate_based_ban or throttle.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Rule.Action"),
+			},
+			{
+				Name:        "description",
+				Description: "An optional description of this rule.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Rule.Description"),
+			},
+			{
+				Name:        "preview",
+				Description: "If set to true, the specified action is not enforced and only logged.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Rule.Preview"),
+			},
+			{
+				Name:        "match_versioned_expr",
+				Description: "Preconfigured versioned expression of the rule. Can only be SRC_IPS_V1.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Rule.Match.VersionedExpr"),
+			},
+			{
+				Name:        "match_src_ip_ranges",
+				Description: "CIDR IP address ranges matched by the versioned expression of the rule.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Rule.Match.Config.SrcIpRanges"),
+			},
+			{
+				Name:        "match_expr",
+				Description: "The user defined CEL expression matched by the rule.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Rule.Match.Expr.Expression"),
+			},
+			{
+				Name:        "match_expr_options",
+				Description: "The options for the CEL expression, such as the reCAPTCHA options.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Rule.Match.ExprOptions"),
+			},
+			{
+				Name:        "preconfigured_waf_rule_sets",
+				Description: "The preconfigured WAF rule sets, such as sqli-v33-stable, evaluated by the CEL expression of the rule.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Rule.Match.Expr.Expression").Transform(gcpComputeSecurityPolicyRulePreconfiguredWafRuleSets),
+			},
+			{
+				Name:        "preconfigured_waf_config",
+				Description: "The exclusions of the preconfigured WAF rule sets evaluated by the rule.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Rule.PreconfiguredWafConfig"),
+			},
+			{
+				Name:        "network_match",
+				Description: "A match condition for CLOUD_ARMOR_NETWORK security policies.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Rule.NetworkMatch"),
+			},
+			{
+				Name:        "header_action",
+				Description: "Optional, additional actions that are performed on headers.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Rule.HeaderAction"),
+			},
+			{
+				Name:        "rate_limit_options",
+				Description: "Must be specified if the action is rate_based_ban or throttle. Contains the thresholds and keys used for rate limiting.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Rule.RateLimitOptions"),
+			},
+			{
+				Name:        "redirect_options",
+				Description: "Parameters defining the redirect action. Cannot be specified for any other actions.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Rule.RedirectOptions"),
+			},
+			{
+				Name:        "security_policy_type",
+				Description: "The type of the security policy the rule belongs to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SecurityPolicy.Type"),
+			},
+			{
+				Name:        "adaptive_protection_enabled",
+				Description: "Indicates whether Adaptive Protection layer 7 DDoS defense is enabled for the security policy.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("SecurityPolicy.AdaptiveProtectionConfig.Layer7DdosDefenseConfig.Enable"),
+				Default:     false,
+			},
+			{
+				Name:        "adaptive_protection_config",
+				Description: "The Adaptive Protection configuration of the security policy the rule belongs to.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("SecurityPolicy.AdaptiveProtectionConfig"),
+			},
+			{
+				Name:        "security_policy_self_link",
+				Description: "Server-defined URL of the security policy.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SecurityPolicy.SelfLink"),
+			},
+
+			// standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(gcpComputeSecurityPolicyRuleTitle),
+			},
+
+			// standard gcp columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(gcpComputeSecurityPolicyRuleLocation, "Location"),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(gcpComputeSecurityPolicyRuleLocation, "Project"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listComputeSecurityPolicyRules(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	securityPolicy := h.Item.(*compute.SecurityPolicy)
+
+	// Minimize the processing with the given security policy
+	securityPolicyName := d.EqualsQualString("security_policy_name")
+	if securityPolicyName != "" && securityPolicyName != securityPolicy.Name {
+		return nil, nil
+	}
+
+	// Rules are returned inline with the security policy
+	for _, rule := range securityPolicy.Rules {
+		d.StreamLeafListItem(ctx, securityPolicyRuleInfo{rule, securityPolicy})
+
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func gcpComputeSecurityPolicyRulePreconfiguredWafRuleSets(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	expression, ok := d.Value.(string)
+	if !ok || expression == "" {
+		return nil, nil
+	}
+
+	ruleSets := []string{}
+	for _, match := range preconfiguredWafRuleSetRegex.FindAllStringSubmatch(expression, -1) {
+		ruleSets = append(ruleSets, match[1])
+	}
+	if len(ruleSets) == 0 {
+		return nil, nil
+	}
+
+	return ruleSets, nil
+}
+
+func gcpComputeSecurityPolicyRuleTitle(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	data := d.HydrateItem.(securityPolicyRuleInfo)
+	return data.SecurityPolicy.Name + "/" + strconv.FormatInt(data.Rule.Priority, 10), nil
+}
+
+func gcpComputeSecurityPolicyRuleLocation(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	data := d.HydrateItem.(securityPolicyRuleInfo)
+	param := d.Param.(string)
+
+	return computeSecurityPolicyLocationData(data.SecurityPolicy)[param], nil
+}
@@ -0,0 +1,300 @@
+package gcp
+
+import (
+	"context"
+	"strings"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"google.golang.org/api/compute/v1"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpComputeServiceAttachment(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_compute_service_attachment",
+		Description: "GCP Compute Service Attachment",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			Hydrate:    getComputeServiceAttachment,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listComputeServiceAttachments,
+			KeyColumns: plugin.KeyColumnSlice{
+				// String columns
+				{Name: "connection_preference", Require: plugin.Optional, Operators: []string{"<>", "="}},
+				// Boolean columns
+				{Name: "enable_proxy_protocol", Require: plugin.Optional, Operators: []string{"<>", "="}},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "Name of the resource. Provided by the client when the resource is created.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The unique identifier for the resource type. The server generates this identifier.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "connection_preference",
+				Description: "The connection preference of service attachment, either ACCEPT_AUTOMATIC or ACCEPT_MANUAL.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "creation_timestamp",
+				Description: "Creation timestamp in RFC3339 text format.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "description",
+				Description: "An optional description of this resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "kind",
+				Description: "Type of the resource. Always compute#serviceAttachment for service attachments.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "enable_proxy_protocol",
+				Description: "If true, enable the proxy protocol which is for supplying client TCP/IP address data in TCP connections that traverse proxies on their way to destination servers.",
+				Type:        proto.ColumnType_BOOL,
+				Default:     false,
+			},
+			{
+				Name:        "reconcile_connections",
+				Description: "If true, update the connection policy of existing connections to match the consumer accept and reject lists.",
+				Type:        proto.ColumnType_BOOL,
+				Default:     false,
+			},
+			{
+				Name:        "fingerprint",
+				Description: "Fingerprint of this resource. A hash of the contents stored in this object, used for optimistic locking.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "producer_forwarding_rule",
+				Description: "The URL of a forwarding rule with loadBalancingScheme INTERNAL* that is serving the endpoint identified by this service attachment.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "target_service",
+				Description: "The URL of a service serving the endpoint identified by this service attachment.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "propagated_connection_limit",
+				Description: "The number of consumer spokes that connected Private Service Connect endpoints can be propagated to through Network Connectivity Center.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "connected_endpoint_count",
+				Description: "The number of consumer endpoints connected to this service attachment.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.From(gcpComputeServiceAttachmentConnectedEndpointCount),
+			},
+			{
+				Name:        "region",
+				Description: "The URL of the region where the service attachment resides.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "self_link",
+				Description: "Server-defined URL for the resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "connected_endpoints",
+				Description: "An array of connections for all the consumers connected to this service attachment, including the consumer network, endpoint and status.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "consumer_accept_lists",
+				Description: "Projects that are allowed to connect to this service attachment.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "consumer_reject_lists",
+				Description: "Projects that are not allowed to connect to this service attachment.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "domain_names",
+				Description: "The DNS domain names that are used during DNS integration on PSC connected endpoints.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "nat_subnets",
+				Description: "An array of URLs where each entry is the URL of a subnet provided by the service producer to use for NAT in this service attachment.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "psc_service_attachment_id",
+				Description: "An 128-bit global unique ID of the PSC service attachment.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromP(gcpComputeServiceAttachmentTurbotData, "Akas"),
+			},
+
+			// standard gcp columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(gcpComputeServiceAttachmentTurbotData, "Location"),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(gcpComputeServiceAttachmentTurbotData, "Project"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listComputeServiceAttachments(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("listComputeServiceAttachments")
+
+	// Create Service Connection
+	service, err := ComputeService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	filterQuals := []filterQualMap{
+		{"connection_preference", "connectionPreference", "string"},
+		{"enable_proxy_protocol", "enableProxyProtocol", "boolean"},
+	}
+
+	filters := buildQueryFilterFromQuals(filterQuals, d.Quals)
+	filterString := ""
+	if len(filters) > 0 {
+		filterString = strings.Join(filters, " ")
+	}
+
+	// Max limit is set as per documentation
+	// https://pkg.go.dev/google.golang.org/api@v0.214.0/compute/v1#ServiceAttachmentsAggregatedListCall.MaxResults
+	pageSize := types.Int64(500)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	// Get project details
+
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	resp := service.ServiceAttachments.AggregatedList(project).Filter(filterString).MaxResults(*pageSize)
+	if err := resp.Pages(ctx, func(page *compute.ServiceAttachmentAggregatedList) error {
+		for _, item := range page.Items {
+			for _, serviceAttachment := range item.ServiceAttachments {
+				d.StreamListItem(ctx, serviceAttachment)
+
+				// Check if context has been cancelled or if the limit has been hit (if specified)
+				// if there is a limit, it will return the number of rows required to reach this limit
+				if d.RowsRemaining(ctx) == 0 {
+					page.NextPageToken = ""
+					return nil
+				}
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getComputeServiceAttachment(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getComputeServiceAttachment")
+
+	// Create Service Connection
+	service, err := ComputeService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get project details
+
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+	name := d.EqualsQuals["name"].GetStringValue()
+
+	var serviceAttachment compute.ServiceAttachment
+	resp := service.ServiceAttachments.AggregatedList(project).Filter("name=" + name)
+	if err := resp.Pages(ctx, func(page *compute.ServiceAttachmentAggregatedList) error {
+		for _, item := range page.Items {
+			for _, i := range item.ServiceAttachments {
+				serviceAttachment = *i
+			}
+		}
+		return nil
+	},
+	); err != nil {
+		return nil, err
+	}
+
+	// If the specified resource is not present, API does not return any not found errors
+	if len(serviceAttachment.Name) < 1 {
+		return nil, nil
+	}
+
+	return &serviceAttachment, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func gcpComputeServiceAttachmentConnectedEndpointCount(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	data := d.HydrateItem.(*compute.ServiceAttachment)
+	return len(data.ConnectedEndpoints), nil
+}
+
+func gcpComputeServiceAttachmentTurbotData(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	data := d.HydrateItem.(*compute.ServiceAttachment)
+	param := d.Param.(string)
+
+	regionName := getLastPathElement(types.SafeString(data.Region))
+	project := strings.Split(data.SelfLink, "/")[6]
+
+	turbotData := map[string]interface{}{
+		"Location": regionName,
+		"Project":  project,
+		"Akas":     []string{"gcp://compute.googleapis.com/projects/" + project + "/regions/" + regionName + "/serviceAttachments/" + data.Name},
+	}
+
+	return turbotData[param], nil
+}
@@ -0,0 +1,126 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"google.golang.org/api/compute/v1"
+)
+
+type sharedVpcServiceProjectInfo = struct {
+	HostProject    string
+	ServiceProject string
+	ResourceType   string
+	Project        string
+}
+
+//// TABLE DEFINITION
+
+func tableGcpComputeSharedVpcServiceProject(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_compute_shared_vpc_service_project",
+		Description: "GCP Compute Shared VPC Service Project",
+		List: &plugin.ListConfig{
+			Hydrate: listComputeSharedVpcServiceProjects,
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "host_project",
+				Description: "The ID of the Shared VPC host project.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "service_project",
+				Description: "The ID of the service project attached to the Shared VPC host project.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "resource_type",
+				Description: "The type of the service resource attached to the host project, such as PROJECT.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ServiceProject"),
+			},
+
+			// standard gcp columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromConstant("global"),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listComputeSharedVpcServiceProjects(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Create Service Connection
+	service, err := ComputeService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_compute_shared_vpc_service_project.listComputeSharedVpcServiceProjects", "service_error", err)
+		return nil, err
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	projectData, err := service.Projects.Get(project).Do()
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_compute_shared_vpc_service_project.listComputeSharedVpcServiceProjects", "api_error", err)
+		return nil, err
+	}
+
+	// For a service project, return its attachment to the host project
+	if projectData.XpnProjectStatus != "HOST" {
+		host, err := service.Projects.GetXpnHost(project).Do()
+		if err != nil {
+			plugin.Logger(ctx).Error("gcp_compute_shared_vpc_service_project.listComputeSharedVpcServiceProjects", "api_error", err)
+			return nil, err
+		}
+		if host.Name != "" {
+			d.StreamListItem(ctx, sharedVpcServiceProjectInfo{host.Name, project, "PROJECT", project})
+		}
+		return nil, nil
+	}
+
+	// For a host project, return all the attached service projects
+	resp := service.Projects.GetXpnResources(project)
+	if err := resp.Pages(ctx, func(page *compute.ProjectsGetXpnResources) error {
+		for _, resource := range page.Resources {
+			d.StreamListItem(ctx, sharedVpcServiceProjectInfo{project, resource.Id, resource.Type, project})
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				return nil
+			}
+		}
+		return nil
+	}); err != nil {
+		plugin.Logger(ctx).Error("gcp_compute_shared_vpc_service_project.listComputeSharedVpcServiceProjects", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
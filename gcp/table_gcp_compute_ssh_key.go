@@ -0,0 +1,313 @@
+package gcp
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"google.golang.org/api/compute/v1"
+)
+
+type computeSshKeyInfo = struct {
+	Source              string
+	MetadataKey         string
+	InstanceName        string
+	InstanceId          uint64
+	ZoneName            string
+	User                string
+	KeyType             string
+	Key                 string
+	Comment             string
+	Fingerprint         string
+	ExpireOn            *time.Time
+	BlockProjectSshKeys bool
+	OsLoginEnabled      bool
+	Project             string
+}
+
+//// TABLE DEFINITION
+
+func tableGcpComputeSshKey(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_compute_ssh_key",
+		Description: "GCP Compute SSH Key",
+		List: &plugin.ListConfig{
+			Hydrate: listComputeSshKeys,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "source", Require: plugin.Optional},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "user",
+				Description: "The username that the key grants access as.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "key_type",
+				Description: "The type of the public key, e.g. ssh-rsa or ssh-ed25519.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "fingerprint",
+				Description: "The SHA256 fingerprint of the public key, in the OpenSSH format.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "source",
+				Description: "The level of the metadata the key is defined in, either project or instance.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "metadata_key",
+				Description: "The metadata key the key is defined in, either ssh-keys or the legacy sshKeys.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "instance_name",
+				Description: "The name of the instance the key is defined on. Null for project keys.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("InstanceName").NullIfZero(),
+			},
+			{
+				Name:        "instance_id",
+				Description: "The ID of the instance the key is defined on. Null for project keys.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("InstanceId").NullIfZero(),
+			},
+			{
+				Name:        "zone_name",
+				Description: "The zone of the instance the key is defined on. Null for project keys.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ZoneName").NullIfZero(),
+			},
+			{
+				Name:        "expire_on",
+				Description: "The time the key expires, for keys added with an expiry by the Google Cloud console or gcloud.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "is_expired",
+				Description: "True if the key has an expiry time in the past.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.From(computeSshKeyIsExpired),
+			},
+			{
+				Name:        "block_project_ssh_keys",
+				Description: "For instance keys, true if the instance blocks project-wide SSH keys, either with the block-project-ssh-keys metadata key or the legacy sshKeys metadata key. Always false for project keys.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "os_login_enabled",
+				Description: "True if OS Login is enabled for the project, or for the instance for instance keys. SSH keys in metadata are ignored when OS Login is enabled.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "comment",
+				Description: "The comment of the public key, usually the username or a JSON object with the username and expiry time.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "key",
+				Description: "The base64 encoded public key.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("User"),
+			},
+
+			// standard gcp columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(computeSshKeyLocation),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listComputeSshKeys(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Create Service Connection
+	service, err := ComputeService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_compute_ssh_key.listComputeSshKeys", "service_error", err)
+		return nil, err
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	source := d.EqualsQualString("source")
+
+	// The project metadata is needed for the instance keys too, to know whether
+	// OS Login is enabled at the project level
+	projectData, err := service.Projects.Get(project).Do()
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_compute_ssh_key.listComputeSshKeys", "api_error", err)
+		return nil, err
+	}
+	projectOsLogin := computeMetadataBool(projectData.CommonInstanceMetadata, "enable-oslogin")
+
+	if source == "" || source == "project" {
+		for _, metadataKey := range []string{"ssh-keys", "sshKeys"} {
+			value, ok := computeMetadataValue(projectData.CommonInstanceMetadata, metadataKey)
+			if !ok {
+				continue
+			}
+			for _, key := range parseComputeSshKeys(value) {
+				key.Source = "project"
+				key.MetadataKey = metadataKey
+				key.OsLoginEnabled = projectOsLogin
+				key.Project = project
+				d.StreamListItem(ctx, key)
+
+				// Check if context has been cancelled or if the limit has been hit (if specified)
+				// if there is a limit, it will return the number of rows required to reach this limit
+				if d.RowsRemaining(ctx) == 0 {
+					return nil, nil
+				}
+			}
+		}
+	}
+
+	if source != "" && source != "instance" {
+		return nil, nil
+	}
+
+	// Max limit is set as per documentation
+	// https://pkg.go.dev/google.golang.org/api@v0.214.0/compute/v1#InstancesAggregatedListCall.MaxResults
+	pageSize := types.Int64(500)
+
+	resp := service.Instances.AggregatedList(project).MaxResults(*pageSize)
+	if err := resp.Pages(ctx, func(page *compute.InstanceAggregatedList) error {
+		for _, item := range page.Items {
+			for _, instance := range item.Instances {
+				// Instance metadata overrides the project metadata
+				effective := computeEffectiveMetadata(projectData.CommonInstanceMetadata, instance.Metadata)
+
+				for _, metadataKey := range []string{"ssh-keys", "sshKeys"} {
+					value, ok := computeMetadataValue(instance.Metadata, metadataKey)
+					if !ok {
+						continue
+					}
+					for _, key := range parseComputeSshKeys(value) {
+						key.Source = "instance"
+						key.MetadataKey = metadataKey
+						key.InstanceName = instance.Name
+						key.InstanceId = instance.Id
+						key.ZoneName = getLastPathElement(instance.Zone)
+						key.BlockProjectSshKeys = effective.BlockProjectSshKeys
+						key.OsLoginEnabled = effective.OsLoginEnabled
+						key.Project = project
+						d.StreamListItem(ctx, key)
+
+						// Check if context has been cancelled or if the limit has been hit (if specified)
+						// if there is a limit, it will return the number of rows required to reach this limit
+						if d.RowsRemaining(ctx) == 0 {
+							page.NextPageToken = ""
+							return nil
+						}
+					}
+				}
+			}
+		}
+		return nil
+	}); err != nil {
+		plugin.Logger(ctx).Error("gcp_compute_ssh_key.listComputeSshKeys", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func computeSshKeyIsExpired(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	data := d.HydrateItem.(computeSshKeyInfo)
+	return data.ExpireOn != nil && data.ExpireOn.Before(time.Now()), nil
+}
+
+func computeSshKeyLocation(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	data := d.HydrateItem.(computeSshKeyInfo)
+	if data.ZoneName != "" {
+		return data.ZoneName, nil
+	}
+	return "global", nil
+}
+
+//// UTILITY FUNCTIONS
+
+// parseComputeSshKeys parses the value of a ssh-keys metadata item, which
+// has one key per line in the format USERNAME:KEY_TYPE KEY COMMENT. Keys added
+// with an expiry have a comment in the format
+// google-ssh {"userName":"USERNAME","expireOn":"EXPIRE_TIME"}
+func parseComputeSshKeys(value string) []computeSshKeyInfo {
+	var keys []computeSshKeyInfo
+	for _, line := range strings.Split(value, "\n") {
+		line = strings.TrimSpace(line)
+		user, publicKey, found := strings.Cut(line, ":")
+		if !found {
+			continue
+		}
+
+		fields := strings.Fields(publicKey)
+		if len(fields) < 2 {
+			continue
+		}
+
+		key := computeSshKeyInfo{
+			User:    user,
+			KeyType: fields[0],
+			Key:     fields[1],
+			Comment: strings.Join(fields[2:], " "),
+		}
+
+		if blob, err := base64.StdEncoding.DecodeString(key.Key); err == nil {
+			sum := sha256.Sum256(blob)
+			key.Fingerprint = "SHA256:" + base64.RawStdEncoding.EncodeToString(sum[:])
+		}
+
+		if len(fields) > 3 && fields[2] == "google-ssh" {
+			var comment struct {
+				ExpireOn string `json:"expireOn"`
+			}
+			if err := json.Unmarshal([]byte(strings.Join(fields[3:], " ")), &comment); err == nil {
+				for _, layout := range []string{"2006-01-02T15:04:05-0700", time.RFC3339} {
+					if expireOn, err := time.Parse(layout, comment.ExpireOn); err == nil {
+						key.ExpireOn = &expireOn
+						break
+					}
+				}
+			}
+		}
+
+		keys = append(keys, key)
+	}
+
+	return keys
+}
@@ -0,0 +1,286 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpComputeVpcFlowLog(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_compute_vpc_flow_log",
+		Description: "GCP Compute VPC Flow Log",
+		List: &plugin.ListConfig{
+			Hydrate: listComputeVpcFlowLogs,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "src_ip", Require: plugin.Optional},
+				{Name: "dest_ip", Require: plugin.Optional},
+				{Name: "src_port", Require: plugin.Optional, Operators: []string{"=", ">", "<", ">=", "<="}},
+				{Name: "dest_port", Require: plugin.Optional, Operators: []string{"=", ">", "<", ">=", "<="}},
+				{Name: "protocol", Require: plugin.Optional},
+				{Name: "reporter", Require: plugin.Optional},
+				{Name: "src_instance_name", Require: plugin.Optional},
+				{Name: "dest_instance_name", Require: plugin.Optional},
+				{Name: "subnetwork_name", Require: plugin.Optional},
+				{Name: "receive_timestamp", Require: plugin.Optional, Operators: []string{"=", ">", "<", ">=", "<="}},
+				{Name: "timestamp", Require: plugin.Optional, Operators: []string{"=", ">", "<", ">=", "<="}},
+				{Name: "filter", Require: plugin.Optional, CacheMatch: "exact"},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "insert_id",
+				Description: "A unique identifier for the log entry.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Entry.InsertId"),
+			},
+			{
+				Name:        "timestamp",
+				Description: "The time the log entry was written, which is the end of the aggregation interval of the flow.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Entry.Timestamp"),
+				Sort:        plugin.SortAll,
+			},
+			{
+				Name:        "receive_timestamp",
+				Description: "The time the log entry was received by Logging.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Entry.ReceiveTimestamp"),
+			},
+			{
+				Name:        "reporter",
+				Description: "The side which reported the flow, either SRC or DEST.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Payload.reporter"),
+			},
+			{
+				Name:        "src_ip",
+				Description: "The source IP address of the connection.",
+				Type:        proto.ColumnType_IPADDR,
+				Transform:   transform.FromField("Payload.connection.src_ip"),
+			},
+			{
+				Name:        "src_port",
+				Description: "The source port of the connection.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Payload.connection.src_port"),
+			},
+			{
+				Name:        "dest_ip",
+				Description: "The destination IP address of the connection.",
+				Type:        proto.ColumnType_IPADDR,
+				Transform:   transform.FromField("Payload.connection.dest_ip"),
+			},
+			{
+				Name:        "dest_port",
+				Description: "The destination port of the connection.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Payload.connection.dest_port"),
+			},
+			{
+				Name:        "protocol",
+				Description: "The IANA protocol number of the connection, e.g. 6 for TCP or 17 for UDP.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Payload.connection.protocol"),
+			},
+			{
+				Name:        "bytes_sent",
+				Description: "The number of bytes sent from the source to the destination.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Payload.bytes_sent"),
+			},
+			{
+				Name:        "packets_sent",
+				Description: "The number of packets sent from the source to the destination.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Payload.packets_sent"),
+			},
+			{
+				Name:        "rtt_msec",
+				Description: "The latency of the connection in milliseconds, measured during the aggregation interval. Only set for TCP flows.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Payload.rtt_msec"),
+			},
+			{
+				Name:        "start_time",
+				Description: "The time of the first observed packet during the aggregation interval.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Payload.start_time"),
+			},
+			{
+				Name:        "end_time",
+				Description: "The time of the last observed packet during the aggregation interval.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Payload.end_time"),
+			},
+			{
+				Name:        "src_instance_name",
+				Description: "The name of the source VM instance, if the source is a VM in the same VPC network.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Payload.src_instance.vm_name"),
+			},
+			{
+				Name:        "src_instance_zone",
+				Description: "The zone of the source VM instance.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Payload.src_instance.zone"),
+			},
+			{
+				Name:        "src_vpc_name",
+				Description: "The name of the VPC network of the source.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Payload.src_vpc.vpc_name"),
+			},
+			{
+				Name:        "src_subnetwork_name",
+				Description: "The name of the subnetwork of the source.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Payload.src_vpc.subnetwork_name"),
+			},
+			{
+				Name:        "dest_instance_name",
+				Description: "The name of the destination VM instance, if the destination is a VM in the same VPC network.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Payload.dest_instance.vm_name"),
+			},
+			{
+				Name:        "dest_instance_zone",
+				Description: "The zone of the destination VM instance.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Payload.dest_instance.zone"),
+			},
+			{
+				Name:        "dest_vpc_name",
+				Description: "The name of the VPC network of the destination.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Payload.dest_vpc.vpc_name"),
+			},
+			{
+				Name:        "dest_subnetwork_name",
+				Description: "The name of the subnetwork of the destination.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Payload.dest_vpc.subnetwork_name"),
+			},
+			{
+				Name:        "subnetwork_name",
+				Description: "The name of the subnetwork that logged the flow.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Entry.Resource.Labels.subnetwork_name"),
+			},
+			{
+				Name:        "subnetwork_id",
+				Description: "The ID of the subnetwork that logged the flow.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Entry.Resource.Labels.subnetwork_id"),
+			},
+			{
+				Name:        "filter",
+				Description: "An additional filter in the Logging query language, combined with the filter of the other columns.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("filter"),
+			},
+			{
+				Name:        "src_instance",
+				Description: "The details of the source VM instance, including its project, region and zone.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Payload.src_instance"),
+			},
+			{
+				Name:        "dest_instance",
+				Description: "The details of the destination VM instance, including its project, region and zone.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Payload.dest_instance"),
+			},
+			{
+				Name:        "src_vpc",
+				Description: "The details of the VPC network of the source, including its project and subnetwork.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Payload.src_vpc"),
+			},
+			{
+				Name:        "dest_vpc",
+				Description: "The details of the VPC network of the destination, including its project and subnetwork.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Payload.dest_vpc"),
+			},
+			{
+				Name:        "src_location",
+				Description: "The geographic location of the source, for sources outside of the VPC network.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Payload.src_location"),
+			},
+			{
+				Name:        "dest_location",
+				Description: "The geographic location of the destination, for destinations outside of the VPC network.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Payload.dest_location"),
+			},
+			{
+				Name:        "src_gke_details",
+				Description: "The GKE cluster, pod and service of the source, if the source is a GKE endpoint.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Payload.src_gke_details"),
+			},
+			{
+				Name:        "dest_gke_details",
+				Description: "The GKE cluster, pod and service of the destination, if the destination is a GKE endpoint.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Payload.dest_gke_details"),
+			},
+			{
+				Name:        "json_payload",
+				Description: "The full flow log record.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Payload"),
+			},
+
+			// Standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Entry.InsertId"),
+			},
+
+			// Standard GCP columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Entry.Resource.Labels.location"),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getProject,
+				Transform:   transform.FromValue(),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listComputeVpcFlowLogs(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	filterQuals := []filterQualMap{
+		{"src_ip", "jsonPayload.connection.src_ip", "ip"},
+		{"dest_ip", "jsonPayload.connection.dest_ip", "ip"},
+		{"src_port", "jsonPayload.connection.src_port", "int"},
+		{"dest_port", "jsonPayload.connection.dest_port", "int"},
+		{"protocol", "jsonPayload.connection.protocol", "int"},
+		{"reporter", "jsonPayload.reporter", "string"},
+		{"src_instance_name", "jsonPayload.src_instance.vm_name", "string"},
+		{"dest_instance_name", "jsonPayload.dest_instance.vm_name", "string"},
+		{"subnetwork_name", "resource.labels.subnetwork_name", "string"},
+		{"receive_timestamp", "receiveTimestamp", "timestamp"},
+		{"timestamp", "timestamp", "timestamp"},
+	}
+
+	logIds := []string{"compute.googleapis.com%2Fvpc_flows"}
+	return nil, listLoggingEntriesOfLogs(ctx, d, h, logIds, nil, filterQuals, newLoggingEntryPayloadInfo)
+}
@@ -0,0 +1,280 @@
+package gcp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"google.golang.org/api/bigquery/v2"
+)
+
+type loggingAnalyticsQueryRow = struct {
+	RowNumber int64
+	Data      map[string]interface{}
+}
+
+//// TABLE DEFINITION
+
+func tableGcpLoggingAnalyticsQuery(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_logging_analytics_query",
+		Description: "GCP Logging Analytics Query",
+		List: &plugin.ListConfig{
+			Hydrate: listLoggingAnalyticsQueryRows,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "query", Require: plugin.Required, CacheMatch: "exact"},
+				{Name: "link_name", Require: plugin.Optional, CacheMatch: "exact"},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "query",
+				Description: "The GoogleSQL query to run against the BigQuery dataset linked to a Log Analytics bucket, e.g. select timestamp, severity from `my-project.my_dataset._AllLogs`.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("query"),
+			},
+			{
+				Name:        "link_name",
+				Description: "The resource name of the link of the bucket, in the format projects/{project}/locations/{location}/buckets/{bucket}/links/{link}. If set, the linked dataset is the default dataset of the query, so the views can be referenced by name only, e.g. _AllLogs.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("link_name"),
+			},
+			{
+				Name:        "row_number",
+				Description: "The number of the row in the query result, starting at 1.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "data",
+				Description: "The row of the query result, keyed by column name.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// standard gcp columns
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getProject,
+				Transform:   transform.FromValue(),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listLoggingAnalyticsQueryRows(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	query := d.EqualsQualString("query")
+	if query == "" {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := BigQueryService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_logging_analytics_query.listLoggingAnalyticsQueryRows", "service_error", err)
+		return nil, err
+	}
+
+	// Get project details, the query job runs in the project of the connection
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	// Max limit isn't mentioned in the documentation
+	// Default limit is set as 10000
+	pageSize := types.Int64(10000)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	useLegacySql := false
+	request := &bigquery.QueryRequest{
+		Query:        query,
+		UseLegacySql: &useLegacySql,
+		MaxResults:   *pageSize,
+	}
+
+	if linkName := d.EqualsQualString("link_name"); linkName != "" {
+		defaultDataset, err := getLoggingLinkDataset(ctx, d, linkName)
+		if err != nil {
+			plugin.Logger(ctx).Error("gcp_logging_analytics_query.listLoggingAnalyticsQueryRows", "api_error", err)
+			return nil, err
+		}
+		request.DefaultDataset = defaultDataset
+	}
+
+	resp, err := service.Jobs.Query(project, request).Context(ctx).Do()
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_logging_analytics_query.listLoggingAnalyticsQueryRows", "api_error", err)
+		return nil, err
+	}
+
+	schema, rows, pageToken, complete := resp.Schema, resp.Rows, resp.PageToken, resp.JobComplete
+	rowNumber := int64(0)
+	for {
+		// Stop polling the job once the query has been cancelled
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		if complete {
+			for _, row := range rows {
+				rowNumber++
+				d.StreamListItem(ctx, loggingAnalyticsQueryRow{rowNumber, bigQueryRowToMap(schema.Fields, row)})
+
+				// Check if context has been cancelled or if the limit has been hit (if specified)
+				// if there is a limit, it will return the number of rows required to reach this limit
+				if d.RowsRemaining(ctx) == 0 {
+					return nil, nil
+				}
+			}
+
+			if pageToken == "" {
+				break
+			}
+		}
+
+		// Wait for the job to complete, or get the next page of the results
+		call := service.Jobs.GetQueryResults(project, resp.JobReference.JobId).Location(resp.JobReference.Location).MaxResults(*pageSize).TimeoutMs(10000)
+		if pageToken != "" {
+			call.PageToken(pageToken)
+		}
+		results, err := call.Context(ctx).Do()
+		if err != nil {
+			plugin.Logger(ctx).Error("gcp_logging_analytics_query.listLoggingAnalyticsQueryRows", "api_error", err)
+			return nil, err
+		}
+		schema, rows, pageToken, complete = results.Schema, results.Rows, results.PageToken, results.JobComplete
+	}
+
+	return nil, nil
+}
+
+//// UTILITY FUNCTIONS
+
+// getLoggingLinkDataset returns the BigQuery dataset linked to a Log Analytics
+// bucket by the given link
+func getLoggingLinkDataset(ctx context.Context, d *plugin.QueryData, linkName string) (*bigquery.DatasetReference, error) {
+	service, err := LoggingService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	link, err := service.Locations.Buckets.Links.Get(linkName).Context(ctx).Do()
+	if err != nil {
+		return nil, err
+	}
+	if link.BigqueryDataset == nil {
+		return nil, fmt.Errorf("link %s has no BigQuery dataset", linkName)
+	}
+
+	// The dataset ID is in the format bigquery.googleapis.com/projects/{project}/datasets/{dataset}
+	splitId := strings.Split(link.BigqueryDataset.DatasetId, "/")
+	if len(splitId) < 5 {
+		return nil, fmt.Errorf("unexpected BigQuery dataset %s for link %s", link.BigqueryDataset.DatasetId, linkName)
+	}
+
+	return &bigquery.DatasetReference{ProjectId: splitId[2], DatasetId: splitId[4]}, nil
+}
+
+// bigQueryRowToMap converts a row of a query result to a map keyed by column
+// name. BigQuery returns all the values as strings, so they are converted to
+// the type of the column, and nested and repeated fields are converted
+// recursively.
+func bigQueryRowToMap(fields []*bigquery.TableFieldSchema, row *bigquery.TableRow) map[string]interface{} {
+	data := make(map[string]interface{}, len(fields))
+	for i, field := range fields {
+		if i < len(row.F) {
+			data[field.Name] = bigQueryFieldValue(field, row.F[i].V)
+		}
+	}
+	return data
+}
+
+func bigQueryFieldValue(field *bigquery.TableFieldSchema, value interface{}) interface{} {
+	if value == nil {
+		return nil
+	}
+
+	if field.Mode == "REPEATED" {
+		items, ok := value.([]interface{})
+		if !ok {
+			return value
+		}
+		element := *field
+		element.Mode = "NULLABLE"
+		values := make([]interface{}, 0, len(items))
+		for _, item := range items {
+			if cell, ok := item.(map[string]interface{}); ok {
+				values = append(values, bigQueryFieldValue(&element, cell["v"]))
+			}
+		}
+		return values
+	}
+
+	switch field.Type {
+	case "RECORD", "STRUCT":
+		record, ok := value.(map[string]interface{})
+		if !ok {
+			return value
+		}
+		cells, _ := record["f"].([]interface{})
+		data := make(map[string]interface{}, len(field.Fields))
+		for i, nested := range field.Fields {
+			if i < len(cells) {
+				if cell, ok := cells[i].(map[string]interface{}); ok {
+					data[nested.Name] = bigQueryFieldValue(nested, cell["v"])
+				}
+			}
+		}
+		return data
+	}
+
+	s, ok := value.(string)
+	if !ok {
+		return value
+	}
+
+	switch field.Type {
+	case "INTEGER", "INT64":
+		if i, err := strconv.ParseInt(s, 10, 64); err == nil {
+			return i
+		}
+	case "FLOAT", "FLOAT64":
+		if f, err := strconv.ParseFloat(s, 64); err == nil {
+			return f
+		}
+	case "BOOLEAN", "BOOL":
+		if b, err := strconv.ParseBool(s); err == nil {
+			return b
+		}
+	case "TIMESTAMP":
+		// Timestamps are returned as seconds since the epoch, e.g. 1.7040672E9
+		if f, err := strconv.ParseFloat(s, 64); err == nil {
+			return time.UnixMicro(int64(math.Round(f * 1e6))).UTC().Format(time.RFC3339Nano)
+		}
+	case "JSON":
+		var v interface{}
+		if err := json.Unmarshal([]byte(s), &v); err == nil {
+			return v
+		}
+	}
+
+	return s
+}
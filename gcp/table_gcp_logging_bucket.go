@@ -19,11 +19,18 @@ func tableGcpLoggingBucket(_ context.Context) *plugin.Table {
 		Name:        "gcp_logging_bucket",
 		Description: "GCP Logging Bucket",
 		Get: &plugin.GetConfig{
-			KeyColumns: plugin.AllColumns([]string{"name", "location"}),
-			Hydrate:    getLoggingBucket,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "name", Require: plugin.Required},
+				{Name: "location", Require: plugin.Required},
+				{Name: "parent", Require: plugin.Optional},
+			},
+			Hydrate: getLoggingBucket,
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listLoggingBuckets,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "parent", Require: plugin.Optional},
+			},
 		},
 		Columns: []*plugin.Column{
 			{
@@ -32,6 +39,12 @@ func tableGcpLoggingBucket(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_STRING,
 				Transform:   transform.FromField("Name").Transform(lastPathElement),
 			},
+			{
+				Name:        "parent",
+				Description: "The parent resource of the bucket, e.g. projects/my-project, organizations/123456789, folders/123456789 or billingAccounts/012345-567890-ABCDEF. Defaults to the project of the connection.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(loggingBucketTurbotData, "Parent"),
+			},
 			{
 				Name:        "self_link",
 				Description: "The server-defined URL for the resource.",
@@ -123,16 +136,14 @@ func listLoggingBuckets(ctx context.Context, d *plugin.QueryData, h *plugin.Hydr
 		}
 	}
 
-	// Get project details
-
-	projectId, err := getProject(ctx, d, h)
+	// Get the parent details
+	parent, err := getLoggingParent(ctx, d, h)
 	if err != nil {
 		return nil, err
 	}
-	project := projectId.(string)
 
 	// '-' for all locations...
-	resp := service.Projects.Locations.Buckets.List("projects/" + project + "/locations/-").PageSize(*pageSize)
+	resp := service.Locations.Buckets.List(parent.(string) + "/locations/-").PageSize(*pageSize)
 	if err := resp.Pages(
 		ctx,
 		func(page *logging.ListBucketsResponse) error {
@@ -174,17 +185,15 @@ func getLoggingBucket(ctx context.Context, d *plugin.QueryData, h *plugin.Hydrat
 		return nil, nil
 	}
 
-	// Get project details
-
-	projectId, err := getProject(ctx, d, h)
+	// Get the parent details
+	parent, err := getLoggingParent(ctx, d, h)
 	if err != nil {
 		return nil, err
 	}
-	project := projectId.(string)
 
-	bucketNameWithLocation := "projects/" + project + "/locations/" + locationId + "/buckets/" + bucketName
+	bucketNameWithLocation := parent.(string) + "/locations/" + locationId + "/buckets/" + bucketName
 
-	op, err := service.Projects.Locations.Buckets.Get(bucketNameWithLocation).Do()
+	op, err := service.Locations.Buckets.Get(bucketNameWithLocation).Do()
 	if err != nil {
 		return nil, err
 	}
@@ -203,7 +212,8 @@ func loggingBucketTurbotData(_ context.Context, d *transform.TransformData) (int
 	splittedTitle := strings.Split(data.Name, "/")
 
 	turbotData := map[string]interface{}{
-		"Project":  splittedTitle[1],
+		"Project":  loggingResourceProject(data.Name),
+		"Parent":   splittedTitle[0] + "/" + splittedTitle[1],
 		"Location": splittedTitle[3],
 		"SelfLink": "https://logging.googleapis.com/v2/" + data.Name,
 		"Akas":     []string{"gcp://logging.googleapis.com/" + data.Name},
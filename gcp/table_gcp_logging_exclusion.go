@@ -18,11 +18,17 @@ func tableGcpLoggingExclusion(_ context.Context) *plugin.Table {
 		Name:        "gcp_logging_exclusion",
 		Description: "GCP Logging Exclusion",
 		Get: &plugin.GetConfig{
-			KeyColumns: plugin.SingleColumn("name"),
-			Hydrate:    getGcpLoggingExclusion,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "name", Require: plugin.Required},
+				{Name: "parent", Require: plugin.Optional},
+			},
+			Hydrate: getGcpLoggingExclusion,
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listGcpLoggingExclusions,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "parent", Require: plugin.Optional},
+			},
 		},
 		Columns: []*plugin.Column{
 			{
@@ -30,6 +36,13 @@ func tableGcpLoggingExclusion(_ context.Context) *plugin.Table {
 				Description: "The client-assigned identifier, unique within the project",
 				Type:        proto.ColumnType_STRING,
 			},
+			{
+				Name:        "parent",
+				Description: "The parent resource of the exclusion, e.g. projects/my-project, organizations/123456789, folders/123456789 or billingAccounts/012345-567890-ABCDEF. Defaults to the project of the connection.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getLoggingParent,
+				Transform:   transform.FromValue(),
+			},
 			{
 				Name:        "disabled",
 				Description: "Specifies whether the exclusion is disabled, or not. If disabled it does not exclude any log entries.",
@@ -82,7 +95,7 @@ func tableGcpLoggingExclusion(_ context.Context) *plugin.Table {
 				Name:        "project",
 				Description: ColumnDescriptionProject,
 				Type:        proto.ColumnType_STRING,
-				Hydrate:     getProject,
+				Hydrate:     getLoggingParentProject,
 				Transform:   transform.FromValue(),
 			},
 		},
@@ -108,15 +121,13 @@ func listGcpLoggingExclusions(ctx context.Context, d *plugin.QueryData, h *plugi
 		}
 	}
 
-	// Get project details
-
-	projectId, err := getProject(ctx, d, h)
+	// Get the parent details
+	parent, err := getLoggingParent(ctx, d, h)
 	if err != nil {
 		return nil, err
 	}
-	project := projectId.(string)
 
-	resp := service.Projects.Exclusions.List("projects/" + project).PageSize(*pageSize)
+	resp := service.Exclusions.List(parent.(string)).PageSize(*pageSize)
 	if err := resp.Pages(
 		ctx,
 		func(page *logging.ListExclusionsResponse) error {
@@ -150,17 +161,15 @@ func getGcpLoggingExclusion(ctx context.Context, d *plugin.QueryData, h *plugin.
 		return nil, err
 	}
 
-	// Get project details
-
-	projectId, err := getProject(ctx, d, h)
+	// Get the parent details
+	parent, err := getLoggingParent(ctx, d, h)
 	if err != nil {
 		return nil, err
 	}
-	project := projectId.(string)
 
 	name := d.EqualsQuals["name"].GetStringValue()
 
-	op, err := service.Projects.Exclusions.Get("projects/" + project + "/exclusions/" + name).Do()
+	op, err := service.Exclusions.Get(parent.(string) + "/exclusions/" + name).Do()
 	if err != nil {
 		plugin.Logger(ctx).Debug("getGcpLoggingExclusion__", "ERROR", err)
 		return nil, err
@@ -177,14 +186,12 @@ func getGcpLoggingExclusion(ctx context.Context, d *plugin.QueryData, h *plugin.
 func exclusionNameToAkas(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
 	exclusion := h.Item.(*logging.LogExclusion)
 
-	// Get project details
-
-	projectId, err := getProject(ctx, d, h)
+	// Get the parent details
+	parent, err := getLoggingParent(ctx, d, h)
 	if err != nil {
 		return nil, err
 	}
-	project := projectId.(string)
 
-	akas := []string{"gcp://logging.googleapis.com/projects/" + project + "/exclusions/" + exclusion.Name}
+	akas := []string{"gcp://logging.googleapis.com/" + parent.(string) + "/exclusions/" + exclusion.Name}
 	return akas, nil
 }
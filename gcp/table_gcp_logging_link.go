@@ -0,0 +1,179 @@
+package gcp
+
+import (
+	"context"
+	"strings"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"google.golang.org/api/logging/v2"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpLoggingLink(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_logging_link",
+		Description: "GCP Logging Link",
+		List: &plugin.ListConfig{
+			ParentHydrate: listLoggingBuckets,
+			Hydrate:       listLoggingLinks,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "bucket_name", Require: plugin.Optional},
+				{Name: "location", Require: plugin.Optional},
+				{Name: "parent", Require: plugin.Optional},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the link.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(lastPathElement),
+			},
+			{
+				Name:        "resource_name",
+				Description: "The resource name of the link, in the format projects/{project}/locations/{location}/buckets/{bucket}/links/{link}. Used as the link_name of the gcp_logging_analytics_query table.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "bucket_name",
+				Description: "The name of the log bucket the link belongs to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(loggingLinkTurbotData, "BucketName"),
+			},
+			{
+				Name:        "parent",
+				Description: "The parent resource of the log bucket, e.g. projects/my-project, organizations/123456789, folders/123456789 or billingAccounts/012345-567890-ABCDEF. Defaults to the project of the connection.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(loggingLinkTurbotData, "Parent"),
+			},
+			{
+				Name:        "description",
+				Description: "Describes this link.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "lifecycle_state",
+				Description: "The resource lifecycle state of the link, e.g. ACTIVE or CREATING.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "bigquery_dataset_id",
+				Description: "The full resource name of the linked BigQuery dataset, in the format bigquery.googleapis.com/projects/{project}/datasets/{dataset}.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("BigqueryDataset.DatasetId"),
+			},
+			{
+				Name:        "create_time",
+				Description: "The creation timestamp of the link.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("CreateTime").NullIfZero(),
+			},
+			{
+				Name:        "self_link",
+				Description: "The server-defined URL for the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(loggingLinkTurbotData, "SelfLink"),
+			},
+
+			// standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(lastPathElement),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromP(loggingLinkTurbotData, "Akas"),
+			},
+
+			// standard gcp columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(loggingLinkTurbotData, "Location"),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(loggingLinkTurbotData, "Project"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listLoggingLinks(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	bucket := h.Item.(*logging.LogBucket)
+
+	// Links can only be created for buckets upgraded to Log Analytics
+	if !bucket.AnalyticsEnabled {
+		return nil, nil
+	}
+
+	// Minimize the API call with given bucket and location
+	splitName := strings.Split(bucket.Name, "/")
+	if d.EqualsQualString("bucket_name") != "" && d.EqualsQualString("bucket_name") != splitName[5] {
+		return nil, nil
+	}
+	if d.EqualsQualString("location") != "" && d.EqualsQualString("location") != splitName[3] {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := LoggingService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_logging_link.listLoggingLinks", "service_error", err)
+		return nil, err
+	}
+
+	// Max limit isn't mentioned in the documentation
+	// Default limit is set as 1000
+	pageSize := types.Int64(1000)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	resp := service.Locations.Buckets.Links.List(bucket.Name).PageSize(*pageSize)
+	if err := resp.Pages(ctx, func(page *logging.ListLinksResponse) error {
+		for _, link := range page.Links {
+			d.StreamListItem(ctx, link)
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				return nil
+			}
+		}
+		return nil
+	}); err != nil {
+		plugin.Logger(ctx).Error("gcp_logging_link.listLoggingLinks", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func loggingLinkTurbotData(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	data := d.HydrateItem.(*logging.Link)
+	param := d.Param.(string)
+
+	return loggingBucketResourceTurbotData(data.Name)[param], nil
+}
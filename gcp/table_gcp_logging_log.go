@@ -0,0 +1,156 @@
+package gcp
+
+import (
+	"context"
+	"net/url"
+	"strings"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"google.golang.org/api/logging/v2"
+)
+
+type loggingLogInfo = struct {
+	Parent string
+	Name   string
+}
+
+//// TABLE DEFINITION
+
+func tableGcpLoggingLog(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_logging_log",
+		Description: "GCP Logging Log",
+		List: &plugin.ListConfig{
+			Hydrate: listLoggingLogs,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "parent", Require: plugin.Optional},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The resource name of the log, e.g. projects/my-project/logs/cloudaudit.googleapis.com%2Factivity. It can be used as the log_name of gcp_logging_log_entry.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "log_id",
+				Description: "The URL-decoded identifier of the log, e.g. cloudaudit.googleapis.com/activity.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(loggingLogId),
+			},
+			{
+				Name:        "parent",
+				Description: "The resource the logs were listed for, e.g. projects/my-project, organizations/123456789, folders/123456789, billingAccounts/012345-567890-ABCDEF or a log view such as projects/my-project/locations/global/buckets/my-bucket/views/_AllLogs. Defaults to the project of the connection.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(loggingLogId),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Name").Transform(loggingLogAkas),
+			},
+
+			// standard gcp columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Parent").Transform(loggingLogLocation),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(loggingResourceNameProject),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listLoggingLogs(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Create Service Connection
+	service, err := LoggingService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_logging_log.listLoggingLogs", "service_error", err)
+		return nil, err
+	}
+
+	// Max limit isn't mentioned in the documentation
+	// Default limit is set as 1000
+	pageSize := types.Int64(1000)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	// Get the parent details
+	parentData, err := getLoggingParent(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	parent := parentData.(string)
+
+	resp := service.Logs.List(parent).PageSize(*pageSize)
+	if err := resp.Pages(ctx, func(page *logging.ListLogsResponse) error {
+		for _, name := range page.LogNames {
+			d.StreamListItem(ctx, loggingLogInfo{Parent: parent, Name: name})
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				return nil
+			}
+		}
+		return nil
+	}); err != nil {
+		plugin.Logger(ctx).Error("gcp_logging_log.listLoggingLogs", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func loggingLogId(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	name := d.Value.(string)
+
+	logId := name[strings.LastIndex(name, "/logs/")+len("/logs/"):]
+	if decoded, err := url.PathUnescape(logId); err == nil {
+		return decoded, nil
+	}
+	return logId, nil
+}
+
+func loggingLogAkas(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	return []string{"gcp://logging.googleapis.com/" + d.Value.(string)}, nil
+}
+
+// loggingLogLocation returns the location of the log bucket of a log view
+// parent, or global for the other parents
+func loggingLogLocation(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	splitParent := strings.Split(d.Value.(string), "/")
+	for i := 0; i < len(splitParent)-1; i++ {
+		if splitParent[i] == "locations" {
+			return splitParent[i+1], nil
+		}
+	}
+	return "global", nil
+}
@@ -3,6 +3,8 @@ package gcp
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/turbot/go-kit/types"
@@ -36,6 +38,8 @@ func tableGcpLoggingLogEntry(_ context.Context) *plugin.Table {
 				{Name: "trace", Require: plugin.Optional},
 				{Name: "operation_id", Require: plugin.Optional},
 				{Name: "filter", Require: plugin.Optional, CacheMatch: "exact"},
+				{Name: "order_by", Require: plugin.Optional, CacheMatch: "exact"},
+				{Name: "resource_names", Require: plugin.Optional, CacheMatch: "exact"},
 			},
 		},
 		Columns: []*plugin.Column{
@@ -55,6 +59,18 @@ func tableGcpLoggingLogEntry(_ context.Context) *plugin.Table {
 				Description: "The filter pattern for the search.",
 				Transform:   transform.FromQual("filter"),
 			},
+			{
+				Name:        "order_by",
+				Description: "The order of the entries by timestamp, either asc or desc. Defaults to the order of the order by clause of the query if it is on timestamp, or asc otherwise.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("order_by"),
+			},
+			{
+				Name:        "resource_names",
+				Description: "The resources to list the entries of, e.g. [\"organizations/123456789\", \"folders/123456789\"] or [\"projects/my-project/locations/global/buckets/my-bucket/views/_AllLogs\"]. Defaults to the project of the connection.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromQual("resource_names").Transform(transform.UnmarshalYAML),
+			},
 			{
 				Name:        "operation_id",
 				Description: "An arbitrary operation identifier. Log entries with the same identifier are assumed to be part of the same operation.",
@@ -91,6 +107,7 @@ func tableGcpLoggingLogEntry(_ context.Context) *plugin.Table {
 				Name:        "timestamp",
 				Description: "The time the event described by the log entry occurred.",
 				Type:        proto.ColumnType_TIMESTAMP,
+				Sort:        plugin.SortAll,
 			},
 			{
 				Name:        "trace",
@@ -170,8 +187,7 @@ func tableGcpLoggingLogEntry(_ context.Context) *plugin.Table {
 				Name:        "project",
 				Description: ColumnDescriptionProject,
 				Type:        proto.ColumnType_STRING,
-				Hydrate:     getProject,
-				Transform:   transform.FromValue(),
+				Transform:   transform.FromField("LogName").Transform(loggingResourceNameProject),
 			},
 		},
 	}
@@ -206,9 +222,22 @@ func listGcpLoggingLogEntries(ctx context.Context, d *plugin.QueryData, h *plugi
 	}
 	project := projectId.(string)
 
+	resourceNames, err := getLoggingLogEntryResourceNames(d, project)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_logging_log_entry.listGcpLoggingLogEntries", "invalid_resource_names", err)
+		return nil, err
+	}
+
+	orderBy, err := getLoggingEntryOrderBy(d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_logging_log_entry.listGcpLoggingLogEntries", "invalid_order_by", err)
+		return nil, err
+	}
+
 	param := &logging.ListLogEntriesRequest{
-		PageSize:   *pageSize,
-		ProjectIds: []string{project},
+		PageSize:      *pageSize,
+		ResourceNames: resourceNames,
+		OrderBy:       orderBy,
 	}
 
 	filter := ""
@@ -219,6 +248,21 @@ func listGcpLoggingLogEntries(ctx context.Context, d *plugin.QueryData, h *plugi
 		filter = buildLoggingLogEntryFilterParam(d.Quals)
 	}
 
+	// Restrict the entries to the default window of the connection, if the
+	// query has no condition on the timestamps
+	windowFilter, err := getLoggingEntryDefaultWindowFilter(d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_logging_log_entry.listGcpLoggingLogEntries", "invalid_default_window", err)
+		return nil, err
+	}
+	if windowFilter != "" {
+		if filter == "" {
+			filter = windowFilter
+		} else {
+			filter = "(" + filter + ") AND " + windowFilter
+		}
+	}
+
 	if filter != "" {
 		param.Filter = filter
 	}
@@ -294,9 +338,22 @@ func getGcpLoggingLogEntry(ctx context.Context, d *plugin.QueryData, h *plugin.H
 
 //// UTILITY FUNCTION
 
-func buildLoggingLogEntryFilterParam(equalQuals plugin.KeyColumnQualMap) string {
-	filter := ""
+// getLoggingLogEntryResourceNames returns the resources to list the entries
+// of, from the resource_names column, or the project of the connection
+func getLoggingLogEntryResourceNames(d *plugin.QueryData, project string) ([]string, error) {
+	qual := d.EqualsQuals["resource_names"]
+	if qual == nil {
+		return []string{"projects/" + project}, nil
+	}
+
+	var resourceNames []string
+	if err := json.Unmarshal([]byte(qual.GetJsonbValue()), &resourceNames); err != nil {
+		return nil, fmt.Errorf("resource_names must be an array of resource names, e.g. [\"organizations/123456789\"]: %v", err)
+	}
+	return resourceNames, nil
+}
 
+func buildLoggingLogEntryFilterParam(equalQuals plugin.KeyColumnQualMap) string {
 	filterQuals := []filterQualMap{
 		{"resource_type", "resource.type", "string"},
 		{"severity", "severity", "string"},
@@ -309,6 +366,14 @@ func buildLoggingLogEntryFilterParam(equalQuals plugin.KeyColumnQualMap) string
 		{"timestamp", "timestamp", "timestamp"},
 	}
 
+	return buildLoggingFilterFromQuals(equalQuals, filterQuals)
+}
+
+// buildLoggingFilterFromQuals builds a filter in the Logging query language
+// from the quals of the given columns, combined with AND
+func buildLoggingFilterFromQuals(equalQuals plugin.KeyColumnQualMap, filterQuals []filterQualMap) string {
+	filter := ""
+
 	for _, filterQualItem := range filterQuals {
 		filterQual := equalQuals[filterQualItem.ColumnName]
 		if filterQual == nil {
@@ -332,6 +397,25 @@ func buildLoggingLogEntryFilterParam(equalQuals plugin.KeyColumnQualMap) string
 					} else {
 						filter = filter + " AND " + filterQualItem.PropertyPath + " = \"" + value.GetStringValue() + "\""
 					}
+				case "ip":
+					// Quals on IPADDR columns are passed as inet values
+					ip := value.GetStringValue()
+					if inet := value.GetInetValue(); inet != nil {
+						ip = inet.Addr
+					}
+					condition := filterQualItem.PropertyPath + " = \"" + ip + "\""
+					if filter == "" {
+						filter = condition
+					} else {
+						filter = filter + " AND " + condition
+					}
+				case "int":
+					condition := filterQualItem.PropertyPath + " " + qual.Operator + " " + strconv.FormatInt(value.GetInt64Value(), 10)
+					if filter == "" {
+						filter = condition
+					} else {
+						filter = filter + " AND " + condition
+					}
 				case "timestamp":
 					propertyPath := filterQualItem.PropertyPath
 					if filter == "" {
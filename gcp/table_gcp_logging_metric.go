@@ -2,6 +2,8 @@ package gcp
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"github.com/turbot/go-kit/types"
 	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
@@ -18,11 +20,17 @@ func tableGcpLoggingMetric(_ context.Context) *plugin.Table {
 		Name:        "gcp_logging_metric",
 		Description: "GCP Logging Metric",
 		Get: &plugin.GetConfig{
-			KeyColumns: plugin.SingleColumn("name"),
-			Hydrate:    getGcpLoggingMetric,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "name", Require: plugin.Required},
+				{Name: "parent", Require: plugin.Optional},
+			},
+			Hydrate: getGcpLoggingMetric,
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listGcpLoggingMetrics,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "parent", Require: plugin.Optional},
+			},
 		},
 		Columns: []*plugin.Column{
 			{
@@ -30,6 +38,13 @@ func tableGcpLoggingMetric(_ context.Context) *plugin.Table {
 				Description: "The client-assigned metric identifier.",
 				Type:        proto.ColumnType_STRING,
 			},
+			{
+				Name:        "parent",
+				Description: "The project of the metric, in the format projects/my-project. Log-based metrics can only be created in projects, but can count the log entries of an organization, folder or billing account log bucket. Defaults to the project of the connection.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getLoggingParent,
+				Transform:   transform.FromValue(),
+			},
 			{
 				Name:        "description",
 				Description: "A user-specified, human-readable description of the metric.",
@@ -170,7 +185,7 @@ func tableGcpLoggingMetric(_ context.Context) *plugin.Table {
 				Name:        "project",
 				Description: ColumnDescriptionProject,
 				Type:        proto.ColumnType_STRING,
-				Hydrate:     getProject,
+				Hydrate:     getLoggingParentProject,
 				Transform:   transform.FromValue(),
 			},
 		},
@@ -196,15 +211,13 @@ func listGcpLoggingMetrics(ctx context.Context, d *plugin.QueryData, h *plugin.H
 		}
 	}
 
-	// Get project details
-
-	projectId, err := getProject(ctx, d, h)
+	// Get the parent details
+	parent, err := getLoggingMetricParent(ctx, d, h)
 	if err != nil {
 		return nil, err
 	}
-	project := projectId.(string)
 
-	resp := service.Projects.Metrics.List("projects/" + project).PageSize(*pageSize)
+	resp := service.Projects.Metrics.List(parent).PageSize(*pageSize)
 	if err := resp.Pages(
 		ctx,
 		func(page *logging.ListLogMetricsResponse) error {
@@ -238,16 +251,14 @@ func getGcpLoggingMetric(ctx context.Context, d *plugin.QueryData, h *plugin.Hyd
 		return nil, err
 	}
 
-	// Get project details
-
-	projectId, err := getProject(ctx, d, h)
+	// Get the parent details
+	parent, err := getLoggingMetricParent(ctx, d, h)
 	if err != nil {
 		return nil, err
 	}
-	project := projectId.(string)
 	name := d.EqualsQuals["name"].GetStringValue()
 
-	op, err := service.Projects.Metrics.Get("projects/" + project + "/metrics/" + name).Do()
+	op, err := service.Projects.Metrics.Get(parent + "/metrics/" + name).Do()
 	if err != nil {
 		plugin.Logger(ctx).Debug("getGcpLoggingMetric__", "ERROR", err)
 		return nil, err
@@ -264,14 +275,27 @@ func getGcpLoggingMetric(ctx context.Context, d *plugin.QueryData, h *plugin.Hyd
 func metricNameToAkas(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
 	metric := h.Item.(*logging.LogMetric)
 
-	// Get project details
-
-	projectId, err := getProject(ctx, d, h)
+	// Get the parent details
+	parent, err := getLoggingParent(ctx, d, h)
 	if err != nil {
 		return nil, err
 	}
-	project := projectId.(string)
 
-	akas := []string{"gcp://logging.googleapis.com/projects/" + project + "/metrics/" + metric.Name}
+	akas := []string{"gcp://logging.googleapis.com/" + parent.(string) + "/metrics/" + metric.Name}
 	return akas, nil
 }
+
+// getLoggingMetricParent returns the parent of the log-based metrics, which
+// can only be a project
+func getLoggingMetricParent(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (string, error) {
+	parent, err := getLoggingParent(ctx, d, h)
+	if err != nil {
+		return "", err
+	}
+
+	if !strings.HasPrefix(parent.(string), "projects/") {
+		return "", fmt.Errorf("log-based metrics can only be listed for projects, the parent must be in the format projects/{project}, got %s", parent)
+	}
+
+	return parent.(string), nil
+}
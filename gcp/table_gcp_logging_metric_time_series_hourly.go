@@ -0,0 +1,55 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	"google.golang.org/api/logging/v2"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpLoggingMetricTimeSeriesHourly(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_logging_metric_time_series_hourly",
+		Description: "GCP Logging Metric Time Series (Hourly)",
+		List: &plugin.ListConfig{
+			ParentHydrate: listGcpLoggingMetrics,
+			Hydrate:       listLoggingMetricTimeSeriesHourly,
+			KeyColumns:    plugin.OptionalColumns([]string{"name"}),
+		},
+		Columns: monitoringMetricColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the log-based metric, as in gcp_logging_metric.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Metric.Type").Transform(loggingMetricTimeSeriesName),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listLoggingMetricTimeSeriesHourly(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	metric := h.Item.(*logging.LogMetric)
+
+	// Minimize the API calls with the given metric
+	if d.EqualsQualString("name") != "" && d.EqualsQualString("name") != metric.Name {
+		return nil, nil
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	metricType := "\"" + loggingMetricTimeSeriesPrefix + metric.Name + "\""
+	dimensionValue := "\"" + project + "\""
+
+	return listMonitorMetricStatistics(ctx, d, h, "HOURLY", metricType, "resource.labels.project_id = ", dimensionValue, metric.Name, "global")
+}
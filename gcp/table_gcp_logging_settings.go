@@ -0,0 +1,132 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	"google.golang.org/api/logging/v2"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpLoggingSettings(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_logging_settings",
+		Description: "GCP Logging Settings",
+		List: &plugin.ListConfig{
+			Hydrate: listLoggingSettings,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "parent", Require: plugin.Optional},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The resource name of the settings, e.g. projects/my-project/settings.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "parent",
+				Description: "The resource the settings apply to, e.g. projects/my-project, organizations/123456789, folders/123456789 or billingAccounts/012345-567890-ABCDEF. Defaults to the project of the connection.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getLoggingParent,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "kms_key_name",
+				Description: "The resource name of the Cloud KMS key used to encrypt the log buckets created for the resource, for Customer-Managed Encryption Keys (CMEK).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "kms_service_account_id",
+				Description: "The service account that will be used by the Log Router to access the Cloud KMS key.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "logging_service_account_id",
+				Description: "The service account used by the Log Router for the resource, e.g. to write to sink destinations.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "storage_location",
+				Description: "The default location of the _Default and _Required log buckets created for new resources, e.g. us-central1 or global.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "disable_default_sink",
+				Description: "True if the _Default sink is disabled in newly created projects and folders.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "default_sink_config",
+				Description: "Overrides of the built-in configuration of the _Default sink of newly created projects and folders.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.From(loggingSettingsAkas),
+			},
+
+			// standard gcp columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromConstant("global"),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getLoggingParentProject,
+				Transform:   transform.FromValue(),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listLoggingSettings(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Create Service Connection
+	service, err := LoggingService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_logging_settings.listLoggingSettings", "service_error", err)
+		return nil, err
+	}
+
+	// Get the parent details
+	parent, err := getLoggingParent(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := service.V2.GetSettings(parent.(string) + "/settings").Do()
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_logging_settings.listLoggingSettings", "api_error", err)
+		return nil, err
+	}
+
+	d.StreamListItem(ctx, resp)
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func loggingSettingsAkas(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	data := d.HydrateItem.(*logging.Settings)
+	return []string{"gcp://logging.googleapis.com/" + data.Name}, nil
+}
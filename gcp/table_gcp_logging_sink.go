@@ -18,11 +18,17 @@ func tableGcpLoggingSink(_ context.Context) *plugin.Table {
 		Name:        "gcp_logging_sink",
 		Description: "GCP Logging Sink",
 		Get: &plugin.GetConfig{
-			KeyColumns: plugin.SingleColumn("name"),
-			Hydrate:    getGcpLoggingSink,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "name", Require: plugin.Required},
+				{Name: "parent", Require: plugin.Optional},
+			},
+			Hydrate: getGcpLoggingSink,
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listGcpLoggingSinks,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "parent", Require: plugin.Optional},
+			},
 		},
 		Columns: []*plugin.Column{
 			{
@@ -30,6 +36,13 @@ func tableGcpLoggingSink(_ context.Context) *plugin.Table {
 				Description: "The client-assigned sink identifier, unique within the project",
 				Type:        proto.ColumnType_STRING,
 			},
+			{
+				Name:        "parent",
+				Description: "The parent resource of the sink, e.g. projects/my-project, organizations/123456789, folders/123456789 or billingAccounts/012345-567890-ABCDEF. Defaults to the project of the connection.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getLoggingParent,
+				Transform:   transform.FromValue(),
+			},
 			{
 				Name:        "destination",
 				Description: "Specifies the destination, in which the logs will be exported",
@@ -112,7 +125,7 @@ func tableGcpLoggingSink(_ context.Context) *plugin.Table {
 				Name:        "project",
 				Description: ColumnDescriptionProject,
 				Type:        proto.ColumnType_STRING,
-				Hydrate:     getProject,
+				Hydrate:     getLoggingParentProject,
 				Transform:   transform.FromValue(),
 			},
 		},
@@ -138,15 +151,13 @@ func listGcpLoggingSinks(ctx context.Context, d *plugin.QueryData, h *plugin.Hyd
 		}
 	}
 
-	// Get project details
-
-	projectId, err := getProject(ctx, d, h)
+	// Get the parent details
+	parent, err := getLoggingParent(ctx, d, h)
 	if err != nil {
 		return nil, err
 	}
-	project := projectId.(string)
 
-	resp := service.Projects.Sinks.List("projects/" + project).PageSize(*pageSize)
+	resp := service.Sinks.List(parent.(string)).PageSize(*pageSize)
 	if err := resp.Pages(
 		ctx,
 		func(page *logging.ListSinksResponse) error {
@@ -180,17 +191,15 @@ func getGcpLoggingSink(ctx context.Context, d *plugin.QueryData, h *plugin.Hydra
 		return nil, err
 	}
 
-	// Get project details
-
-	projectId, err := getProject(ctx, d, h)
+	// Get the parent details
+	parent, err := getLoggingParent(ctx, d, h)
 	if err != nil {
 		return nil, err
 	}
-	project := projectId.(string)
 
 	name := d.EqualsQuals["name"].GetStringValue()
 
-	op, err := service.Projects.Sinks.Get("projects/" + project + "/sinks/" + name).Do()
+	op, err := service.Sinks.Get(parent.(string) + "/sinks/" + name).Do()
 	if err != nil {
 		plugin.Logger(ctx).Debug("getGcpLoggingSink__", "ERROR", err)
 		return nil, err
@@ -207,29 +216,25 @@ func getGcpLoggingSink(ctx context.Context, d *plugin.QueryData, h *plugin.Hydra
 func sinkNameToAkas(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
 	sink := h.Item.(*logging.LogSink)
 
-	// Get project details
-
-	projectId, err := getProject(ctx, d, h)
+	// Get the parent details
+	parent, err := getLoggingParent(ctx, d, h)
 	if err != nil {
 		return nil, err
 	}
-	project := projectId.(string)
 
-	akas := []string{"gcp://logging.googleapis.com/projects/" + project + "/sinks/" + sink.Name}
+	akas := []string{"gcp://logging.googleapis.com/" + parent.(string) + "/sinks/" + sink.Name}
 	return akas, nil
 }
 
 func getSinkSelfLink(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
 	sink := h.Item.(*logging.LogSink)
 
-	// Get project details
-
-	projectId, err := getProject(ctx, d, h)
+	// Get the parent details
+	parent, err := getLoggingParent(ctx, d, h)
 	if err != nil {
 		return nil, err
 	}
-	project := projectId.(string)
 
-	selfLink := "https://www.googleapis.com/logging/v2/projects/" + project + "/sinks/" + sink.Name
+	selfLink := "https://www.googleapis.com/logging/v2/" + parent.(string) + "/sinks/" + sink.Name
 	return selfLink, nil
 }
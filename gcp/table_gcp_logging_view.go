@@ -0,0 +1,212 @@
+package gcp
+
+import (
+	"context"
+	"strings"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"google.golang.org/api/logging/v2"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpLoggingView(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_logging_view",
+		Description: "GCP Logging View",
+		List: &plugin.ListConfig{
+			ParentHydrate: listLoggingBuckets,
+			Hydrate:       listLoggingViews,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "bucket_name", Require: plugin.Optional},
+				{Name: "location", Require: plugin.Optional},
+				{Name: "parent", Require: plugin.Optional},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the view, e.g. _AllLogs.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(lastPathElement),
+			},
+			{
+				Name:        "bucket_name",
+				Description: "The name of the log bucket the view belongs to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(loggingViewTurbotData, "BucketName"),
+			},
+			{
+				Name:        "parent",
+				Description: "The parent resource of the log bucket, e.g. projects/my-project, organizations/123456789, folders/123456789 or billingAccounts/012345-567890-ABCDEF. Defaults to the project of the connection.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(loggingViewTurbotData, "Parent"),
+			},
+			{
+				Name:        "description",
+				Description: "Describes this view.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "filter",
+				Description: "The filter of the log entries the view gives access to, e.g. SOURCE(\"projects/myproject\") AND resource.type = \"gce_instance\". An empty filter gives access to all the log entries of the bucket.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "create_time",
+				Description: "The creation timestamp of the view.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("CreateTime").NullIfZero(),
+			},
+			{
+				Name:        "update_time",
+				Description: "The last update timestamp of the view.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("UpdateTime").NullIfZero(),
+			},
+			{
+				Name:        "self_link",
+				Description: "The server-defined URL for the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(loggingViewTurbotData, "SelfLink"),
+			},
+			{
+				Name:        "iam_policy",
+				Description: "An Identity and Access Management (IAM) policy, which specifies access controls for the view.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getLoggingViewIamPolicy,
+				Transform:   transform.FromValue(),
+			},
+
+			// standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(lastPathElement),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromP(loggingViewTurbotData, "Akas"),
+			},
+
+			// standard gcp columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(loggingViewTurbotData, "Location"),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(loggingViewTurbotData, "Project"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listLoggingViews(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	bucket := h.Item.(*logging.LogBucket)
+
+	// Minimize the API call with given bucket and location
+	splitName := strings.Split(bucket.Name, "/")
+	if d.EqualsQualString("bucket_name") != "" && d.EqualsQualString("bucket_name") != splitName[5] {
+		return nil, nil
+	}
+	if d.EqualsQualString("location") != "" && d.EqualsQualString("location") != splitName[3] {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := LoggingService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_logging_view.listLoggingViews", "service_error", err)
+		return nil, err
+	}
+
+	// Max limit isn't mentioned in the documentation
+	// Default limit is set as 1000
+	pageSize := types.Int64(1000)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	resp := service.Locations.Buckets.Views.List(bucket.Name).PageSize(*pageSize)
+	if err := resp.Pages(ctx, func(page *logging.ListViewsResponse) error {
+		for _, view := range page.Views {
+			d.StreamListItem(ctx, view)
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				return nil
+			}
+		}
+		return nil
+	}); err != nil {
+		plugin.Logger(ctx).Error("gcp_logging_view.listLoggingViews", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getLoggingViewIamPolicy(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	view := h.Item.(*logging.LogView)
+
+	// Create Service Connection
+	service, err := LoggingService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_logging_view.getLoggingViewIamPolicy", "service_error", err)
+		return nil, err
+	}
+
+	resp, err := service.Locations.Buckets.Views.GetIamPolicy(view.Name, &logging.GetIamPolicyRequest{}).Do()
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_logging_view.getLoggingViewIamPolicy", "api_error", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func loggingViewTurbotData(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	data := d.HydrateItem.(*logging.LogView)
+	param := d.Param.(string)
+
+	return loggingBucketResourceTurbotData(data.Name)[param], nil
+}
+
+// loggingBucketResourceTurbotData returns the parent, project, location,
+// bucket name, self link and akas of a resource of a log bucket from its
+// relative resource name, e.g. projects/my-project/locations/global/buckets/_Default/views/_AllLogs
+func loggingBucketResourceTurbotData(name string) map[string]interface{} {
+	splitName := strings.Split(name, "/")
+
+	return map[string]interface{}{
+		"Parent":     splitName[0] + "/" + splitName[1],
+		"Project":    loggingResourceProject(name),
+		"Location":   splitName[3],
+		"BucketName": splitName[5],
+		"SelfLink":   "https://logging.googleapis.com/v2/" + name,
+		"Akas":       []string{"gcp://logging.googleapis.com/" + name},
+	}
+}
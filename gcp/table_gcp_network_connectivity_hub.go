@@ -0,0 +1,235 @@
+package gcp
+
+import (
+	"context"
+	"strings"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	"google.golang.org/api/networkconnectivity/v1"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpNetworkConnectivityHub(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_network_connectivity_hub",
+		Description: "GCP Network Connectivity Center Hub",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			Hydrate:    getNetworkConnectivityHub,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listNetworkConnectivityHubs,
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the hub, in the format projects/{project_number}/locations/global/hubs/{hub_id}.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "unique_id",
+				Description: "The Google-generated UUID for the hub.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state",
+				Description: "The current lifecycle state of this hub, such as CREATING, ACTIVE, DELETING or UPDATING.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "An optional description of the hub.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "policy_mode",
+				Description: "The policy mode of this hub, which determines whether a custom or preset topology is used.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "preset_topology",
+				Description: "The topology implemented in this hub, such as MESH or STAR.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "export_psc",
+				Description: "Whether Private Service Connect transitivity is enabled for the hub.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "create_time",
+				Description: "The time the hub was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("CreateTime").NullIfZero(),
+			},
+			{
+				Name:        "update_time",
+				Description: "The time the hub was last updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("UpdateTime").NullIfZero(),
+			},
+			{
+				Name:        "self_link",
+				Description: "The server-defined URL for the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(networkConnectivityHubTurbotData, "SelfLink"),
+			},
+			{
+				Name:        "route_tables",
+				Description: "The route tables that belong to this hub.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "routing_vpcs",
+				Description: "The VPC networks associated with this hub's spokes.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "spoke_summary",
+				Description: "A summary of the spokes associated with a hub, including counts of spokes by type and by state.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "labels",
+				Description: "Optional labels in key-value pair format.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(extractLastPartSeparatedByBackslash),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Labels"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromP(networkConnectivityHubTurbotData, "Akas"),
+			},
+
+			// standard gcp columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromConstant("global"),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getProject,
+				Transform:   transform.FromValue(),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listNetworkConnectivityHubs(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Max limit is set as per documentation
+	pageSize := types.Int64(500)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	// Create service connection
+	service, err := NetworkConnectivityService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_network_connectivity_hub.listNetworkConnectivityHubs", "service_error", err)
+		return nil, err
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	// Hubs are only available as global resources
+	parent := "projects/" + project + "/locations/global"
+
+	resp := service.Projects.Locations.Global.Hubs.List(parent).PageSize(*pageSize)
+	if err := resp.Pages(ctx, func(page *networkconnectivity.ListHubsResponse) error {
+		for _, item := range page.Hubs {
+			d.StreamListItem(ctx, item)
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				return nil
+			}
+		}
+		return nil
+	}); err != nil {
+		plugin.Logger(ctx).Error("gcp_network_connectivity_hub.listNetworkConnectivityHubs", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getNetworkConnectivityHub(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	name := d.EqualsQualString("name")
+
+	if name == "" {
+		return nil, nil
+	}
+
+	// Create service connection
+	service, err := NetworkConnectivityService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_network_connectivity_hub.getNetworkConnectivityHub", "service_error", err)
+		return nil, err
+	}
+
+	resp, err := service.Projects.Locations.Global.Hubs.Get(name).Do()
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_network_connectivity_hub.getNetworkConnectivityHub", "api_error", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func networkConnectivityHubTurbotData(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	data := d.HydrateItem.(*networkconnectivity.Hub)
+	param := d.Param.(string)
+
+	return networkConnectivityResourceTurbotData(data.Name)[param], nil
+}
+
+// networkConnectivityResourceTurbotData returns the location, self link and
+// akas of a Network Connectivity Center resource from its relative resource
+// name, e.g. projects/123456789/locations/global/hubs/my-hub
+func networkConnectivityResourceTurbotData(name string) map[string]interface{} {
+	splitName := strings.Split(name, "/")
+
+	return map[string]interface{}{
+		"Location": splitName[3],
+		"SelfLink": "https://networkconnectivity.googleapis.com/v1/" + name,
+		"Akas":     []string{"gcp://networkconnectivity.googleapis.com/" + name},
+	}
+}
@@ -0,0 +1,260 @@
+package gcp
+
+import (
+	"context"
+	"strings"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	"google.golang.org/api/networkconnectivity/v1"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpNetworkConnectivitySpoke(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_network_connectivity_spoke",
+		Description: "GCP Network Connectivity Center Spoke",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			Hydrate:    getNetworkConnectivitySpoke,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listNetworkConnectivitySpokes,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "location", Require: plugin.Optional, Operators: []string{"="}},
+			},
+		},
+		GetMatrixItemFunc: BuildNetworkConnectivityLocationList,
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the spoke, in the format projects/{project}/locations/{location}/spokes/{spoke_id}.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "hub",
+				Description: "The name of the hub that this spoke is attached to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "spoke_group",
+				Description: "The name of the group that this spoke is associated with.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Group"),
+			},
+			{
+				Name:        "spoke_type",
+				Description: "The type of resource associated with the spoke, such as VPN_TUNNEL, INTERCONNECT_ATTACHMENT, ROUTER_APPLIANCE or VPC_NETWORK.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state",
+				Description: "The current lifecycle state of this spoke, such as ACTIVE, INACTIVE or PENDING_REVIEW.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "unique_id",
+				Description: "The Google-generated UUID for the spoke.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "An optional description of the spoke.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "create_time",
+				Description: "The time the spoke was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("CreateTime").NullIfZero(),
+			},
+			{
+				Name:        "update_time",
+				Description: "The time the spoke was last updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("UpdateTime").NullIfZero(),
+			},
+			{
+				Name:        "self_link",
+				Description: "The server-defined URL for the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(networkConnectivitySpokeTurbotData, "SelfLink"),
+			},
+			{
+				Name:        "linked_interconnect_attachments",
+				Description: "The VLAN attachments that are associated with the spoke.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "linked_producer_vpc_network",
+				Description: "The linked producer VPC that is associated with the spoke.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "linked_router_appliance_instances",
+				Description: "The router appliance instances that are associated with the spoke.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "linked_vpc_network",
+				Description: "The VPC network that is associated with the spoke.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "linked_vpn_tunnels",
+				Description: "The VPN tunnels that are associated with the spoke.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "reasons",
+				Description: "The reasons for current state of the spoke, such as PENDING_REVIEW or REJECTED.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "labels",
+				Description: "Optional labels in key-value pair format.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(extractLastPartSeparatedByBackslash),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Labels"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromP(networkConnectivitySpokeTurbotData, "Akas"),
+			},
+
+			// standard gcp columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(networkConnectivitySpokeTurbotData, "Location"),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getProject,
+				Transform:   transform.FromValue(),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listNetworkConnectivitySpokes(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var location string
+	matrixLocation := d.EqualsQualString(matrixKeyLocation)
+	// Since, when the service API is disabled, matrixLocation value will be nil
+	if matrixLocation != "" {
+		location = matrixLocation
+	}
+
+	// Minimize the API call with given location
+	if d.EqualsQualString("location") != "" && d.EqualsQualString("location") != location {
+		return nil, nil
+	}
+
+	// Max limit is set as per documentation
+	pageSize := types.Int64(500)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	// Create service connection
+	service, err := NetworkConnectivityService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_network_connectivity_spoke.listNetworkConnectivitySpokes", "service_error", err)
+		return nil, err
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	parent := "projects/" + project + "/locations/" + location
+
+	resp := service.Projects.Locations.Spokes.List(parent).PageSize(*pageSize)
+	if err := resp.Pages(ctx, func(page *networkconnectivity.ListSpokesResponse) error {
+		for _, item := range page.Spokes {
+			d.StreamListItem(ctx, item)
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				return nil
+			}
+		}
+		return nil
+	}); err != nil {
+		plugin.Logger(ctx).Error("gcp_network_connectivity_spoke.listNetworkConnectivitySpokes", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getNetworkConnectivitySpoke(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	matrixLocation := d.EqualsQualString(matrixKeyLocation)
+
+	name := d.EqualsQualString("name")
+
+	if name == "" {
+		return nil, nil
+	}
+
+	// Restrict the API call for other locations
+	if len(strings.Split(name, "/")) > 3 && strings.Split(name, "/")[3] != matrixLocation {
+		return nil, nil
+	}
+
+	// Create service connection
+	service, err := NetworkConnectivityService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_network_connectivity_spoke.getNetworkConnectivitySpoke", "service_error", err)
+		return nil, err
+	}
+
+	resp, err := service.Projects.Locations.Spokes.Get(name).Do()
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_network_connectivity_spoke.getNetworkConnectivitySpoke", "api_error", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func networkConnectivitySpokeTurbotData(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	data := d.HydrateItem.(*networkconnectivity.Spoke)
+	param := d.Param.(string)
+
+	return networkConnectivityResourceTurbotData(data.Name)[param], nil
+}
@@ -0,0 +1,273 @@
+package gcp
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	"google.golang.org/api/osconfig/v1"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpOSConfigInventory(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_osconfig_inventory",
+		Description: "GCP OS Config Inventory",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			Hydrate:    getOSConfigInventory,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listOSConfigInventories,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "instance_id", Require: plugin.Optional},
+				{Name: "location", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: BuildComputeZoneList,
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the inventory, in the format projects/{project_number}/locations/{zone}/instances/{instance_id}/inventory.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "instance_id",
+				Description: "The unique identifier of the VM instance that the inventory belongs to.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromP(osConfigInventoryTurbotData, "InstanceId"),
+			},
+			{
+				Name:        "hostname",
+				Description: "The VM hostname.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("OsInfo.Hostname"),
+			},
+			{
+				Name:        "short_name",
+				Description: "The operating system short name, e.g. debian or windows.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("OsInfo.ShortName"),
+			},
+			{
+				Name:        "long_name",
+				Description: "The operating system long name, e.g. Debian GNU/Linux 12 (bookworm).",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("OsInfo.LongName"),
+			},
+			{
+				Name:        "version",
+				Description: "The version of the operating system.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("OsInfo.Version"),
+			},
+			{
+				Name:        "architecture",
+				Description: "The system architecture of the operating system, e.g. x86_64.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("OsInfo.Architecture"),
+			},
+			{
+				Name:        "kernel_version",
+				Description: "The kernel version of the operating system.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("OsInfo.KernelVersion"),
+			},
+			{
+				Name:        "kernel_release",
+				Description: "The kernel release of the operating system.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("OsInfo.KernelRelease"),
+			},
+			{
+				Name:        "osconfig_agent_version",
+				Description: "The current version of the OS Config agent running on the VM.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("OsInfo.OsconfigAgentVersion"),
+			},
+			{
+				Name:        "update_time",
+				Description: "The time the inventory was last updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("UpdateTime").NullIfZero(),
+			},
+			{
+				Name:        "self_link",
+				Description: "The server-defined URL for the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(osConfigInventoryTurbotData, "SelfLink"),
+			},
+			{
+				Name:        "items",
+				Description: "The installed and available software packages of the VM, keyed by inventory item ID.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "os_info",
+				Description: "The base level operating system information for the VM.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("OsInfo.Hostname"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromP(osConfigInventoryTurbotData, "Akas"),
+			},
+
+			// standard gcp columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(osConfigInventoryTurbotData, "Location"),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getProject,
+				Transform:   transform.FromValue(),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listOSConfigInventories(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var location string
+	matrixLocation := d.EqualsQualString(matrixKeyLocation)
+	// Since, when the service API is disabled, matrixLocation value will be nil
+	if matrixLocation != "" {
+		location = matrixLocation
+	}
+
+	// Minimize the API call with given location
+	if d.EqualsQualString("location") != "" && d.EqualsQualString("location") != location {
+		return nil, nil
+	}
+
+	// Max limit is set as per documentation
+	pageSize := types.Int64(100)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	// Create service connection
+	service, err := OSConfigService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_osconfig_inventory.listOSConfigInventories", "service_error", err)
+		return nil, err
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	parent := "projects/" + project + "/locations/" + location + "/instances/" + osConfigInstanceParent(d)
+
+	resp := service.Projects.Locations.Instances.Inventories.List(parent).View("FULL").PageSize(*pageSize)
+	if err := resp.Pages(ctx, func(page *osconfig.ListInventoriesResponse) error {
+		for _, item := range page.Inventories {
+			d.StreamListItem(ctx, item)
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				return nil
+			}
+		}
+		return nil
+	}); err != nil {
+		plugin.Logger(ctx).Error("gcp_osconfig_inventory.listOSConfigInventories", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getOSConfigInventory(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	matrixLocation := d.EqualsQualString(matrixKeyLocation)
+
+	name := d.EqualsQualString("name")
+
+	if name == "" {
+		return nil, nil
+	}
+
+	// Restrict the API call for other locations
+	if len(strings.Split(name, "/")) > 3 && strings.Split(name, "/")[3] != matrixLocation {
+		return nil, nil
+	}
+
+	// Create service connection
+	service, err := OSConfigService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_osconfig_inventory.getOSConfigInventory", "service_error", err)
+		return nil, err
+	}
+
+	resp, err := service.Projects.Locations.Instances.Inventories.Get(name).View("FULL").Do()
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_osconfig_inventory.getOSConfigInventory", "api_error", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func osConfigInventoryTurbotData(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	data := d.HydrateItem.(*osconfig.Inventory)
+	param := d.Param.(string)
+
+	return osConfigInstanceResourceTurbotData(data.Name)[param], nil
+}
+
+// osConfigInstanceParent returns the instance segment of the parent of
+// instance-scoped OS Config resources, which is the given instance_id or - for
+// all the instances of the zone
+func osConfigInstanceParent(d *plugin.QueryData) string {
+	if d.EqualsQuals["instance_id"] != nil {
+		return strconv.FormatInt(d.EqualsQuals["instance_id"].GetInt64Value(), 10)
+	}
+	return "-"
+}
+
+// osConfigInstanceResourceTurbotData returns the location, instance ID, self
+// link and akas of an instance-scoped OS Config resource from its relative
+// resource name, e.g. projects/123456789/locations/us-central1-a/instances/987654321/inventory
+func osConfigInstanceResourceTurbotData(name string) map[string]interface{} {
+	splitName := strings.Split(name, "/")
+	instanceId, _ := strconv.ParseInt(splitName[5], 10, 64)
+
+	return map[string]interface{}{
+		"Location":   splitName[3],
+		"InstanceId": instanceId,
+		"SelfLink":   "https://osconfig.googleapis.com/v1/" + name,
+		"Akas":       []string{"gcp://osconfig.googleapis.com/" + name},
+	}
+}
@@ -0,0 +1,238 @@
+package gcp
+
+import (
+	"context"
+	"strings"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	"google.golang.org/api/osconfig/v1"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpOSConfigOSPolicyAssignmentReport(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_osconfig_os_policy_assignment_report",
+		Description: "GCP OS Config OS Policy Assignment Report",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			Hydrate:    getOSConfigOSPolicyAssignmentReport,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listOSConfigOSPolicyAssignmentReports,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "location", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: BuildComputeZoneList,
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the report, in the format projects/{project_number}/locations/{zone}/instances/{instance}/osPolicyAssignments/{os_policy_assignment_id}/report.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "instance",
+				Description: "The Compute Engine VM instance name.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "os_policy_assignment",
+				Description: "The reference to the OS policy assignment that the report is for, including the revision ID.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "compliance_state",
+				Description: "The overall compliance state of the VM for the OS policy assignment. COMPLIANT if all the OS policies are compliant, NON_COMPLIANT if any is non compliant, otherwise UNKNOWN.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(osConfigOSPolicyAssignmentReportComplianceState),
+			},
+			{
+				Name:        "last_run_id",
+				Description: "The unique identifier of the last attempt to run the assigned OS policies on the VM.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "update_time",
+				Description: "The time the report was last generated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("UpdateTime").NullIfZero(),
+			},
+			{
+				Name:        "self_link",
+				Description: "The server-defined URL for the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(osConfigOSPolicyAssignmentReportTurbotData, "SelfLink"),
+			},
+			{
+				Name:        "os_policy_compliances",
+				Description: "The compliance state of each OS policy in the assignment, including the compliance state of each of its resources.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromP(osConfigOSPolicyAssignmentReportTurbotData, "Akas"),
+			},
+
+			// standard gcp columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(osConfigOSPolicyAssignmentReportTurbotData, "Location"),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getProject,
+				Transform:   transform.FromValue(),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listOSConfigOSPolicyAssignmentReports(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var location string
+	matrixLocation := d.EqualsQualString(matrixKeyLocation)
+	// Since, when the service API is disabled, matrixLocation value will be nil
+	if matrixLocation != "" {
+		location = matrixLocation
+	}
+
+	// Minimize the API call with given location
+	if d.EqualsQualString("location") != "" && d.EqualsQualString("location") != location {
+		return nil, nil
+	}
+
+	// Max limit is set as per documentation
+	pageSize := types.Int64(100)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	// Create service connection
+	service, err := OSConfigService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_osconfig_os_policy_assignment_report.listOSConfigOSPolicyAssignmentReports", "service_error", err)
+		return nil, err
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	// The reports of all the instances and OS policy assignments of the zone
+	parent := "projects/" + project + "/locations/" + location + "/instances/-/osPolicyAssignments/-"
+
+	resp := service.Projects.Locations.Instances.OsPolicyAssignments.Reports.List(parent).PageSize(*pageSize)
+	if err := resp.Pages(ctx, func(page *osconfig.ListOSPolicyAssignmentReportsResponse) error {
+		for _, item := range page.OsPolicyAssignmentReports {
+			d.StreamListItem(ctx, item)
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				return nil
+			}
+		}
+		return nil
+	}); err != nil {
+		plugin.Logger(ctx).Error("gcp_osconfig_os_policy_assignment_report.listOSConfigOSPolicyAssignmentReports", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getOSConfigOSPolicyAssignmentReport(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	matrixLocation := d.EqualsQualString(matrixKeyLocation)
+
+	name := d.EqualsQualString("name")
+
+	if name == "" {
+		return nil, nil
+	}
+
+	// Restrict the API call for other locations
+	if len(strings.Split(name, "/")) > 3 && strings.Split(name, "/")[3] != matrixLocation {
+		return nil, nil
+	}
+
+	// Create service connection
+	service, err := OSConfigService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_osconfig_os_policy_assignment_report.getOSConfigOSPolicyAssignmentReport", "service_error", err)
+		return nil, err
+	}
+
+	resp, err := service.Projects.Locations.Instances.OsPolicyAssignments.Reports.Get(name).Do()
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_osconfig_os_policy_assignment_report.getOSConfigOSPolicyAssignmentReport", "api_error", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func osConfigOSPolicyAssignmentReportComplianceState(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	data := d.HydrateItem.(*osconfig.OSPolicyAssignmentReport)
+
+	if len(data.OsPolicyCompliances) == 0 {
+		return "UNKNOWN", nil
+	}
+
+	state := "COMPLIANT"
+	for _, compliance := range data.OsPolicyCompliances {
+		switch compliance.ComplianceState {
+		case "NON_COMPLIANT":
+			return "NON_COMPLIANT", nil
+		case "COMPLIANT":
+		default:
+			state = "UNKNOWN"
+		}
+	}
+
+	return state, nil
+}
+
+func osConfigOSPolicyAssignmentReportTurbotData(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	data := d.HydrateItem.(*osconfig.OSPolicyAssignmentReport)
+	param := d.Param.(string)
+
+	splitName := strings.Split(data.Name, "/")
+
+	turbotData := map[string]interface{}{
+		"Location": splitName[3],
+		"SelfLink": "https://osconfig.googleapis.com/v1/" + data.Name,
+		"Akas":     []string{"gcp://osconfig.googleapis.com/" + data.Name},
+	}
+
+	return turbotData[param], nil
+}
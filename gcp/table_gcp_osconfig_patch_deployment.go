@@ -0,0 +1,200 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	"google.golang.org/api/osconfig/v1"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpOSConfigPatchDeployment(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_osconfig_patch_deployment",
+		Description: "GCP OS Config Patch Deployment",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			Hydrate:    getOSConfigPatchDeployment,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listOSConfigPatchDeployments,
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the patch deployment, in the format projects/{project_id}/patchDeployments/{patch_deployment_id}.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "The description of the patch deployment.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state",
+				Description: "The current state of the patch deployment, either ACTIVE or PAUSED.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "duration",
+				Description: "The duration of the patch job, after which the patch job times out.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "create_time",
+				Description: "The time the patch deployment was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("CreateTime").NullIfZero(),
+			},
+			{
+				Name:        "update_time",
+				Description: "The time the patch deployment was last updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("UpdateTime").NullIfZero(),
+			},
+			{
+				Name:        "last_execute_time",
+				Description: "The last time a patch job was started by this deployment.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("LastExecuteTime").NullIfZero(),
+			},
+			{
+				Name:        "instance_filter",
+				Description: "The VM instances to patch, selected by labels, zones, name prefixes or instance names.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "one_time_schedule",
+				Description: "The schedule of a one-time execution.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "patch_config",
+				Description: "The patch configuration that is applied, including the reboot setting and the per package manager settings.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "recurring_schedule",
+				Description: "The schedule of a recurring execution.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "rollout",
+				Description: "The rollout strategy of the patch job, such as the mode and the disruption budget.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(extractLastPartSeparatedByBackslash),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Name").Transform(osConfigAkas),
+			},
+
+			// standard gcp columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromConstant("global"),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getProject,
+				Transform:   transform.FromValue(),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listOSConfigPatchDeployments(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Max limit is set as per documentation
+	pageSize := types.Int64(100)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	// Create service connection
+	service, err := OSConfigService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_osconfig_patch_deployment.listOSConfigPatchDeployments", "service_error", err)
+		return nil, err
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	resp := service.Projects.PatchDeployments.List("projects/" + project).PageSize(*pageSize)
+	if err := resp.Pages(ctx, func(page *osconfig.ListPatchDeploymentsResponse) error {
+		for _, item := range page.PatchDeployments {
+			d.StreamListItem(ctx, item)
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				return nil
+			}
+		}
+		return nil
+	}); err != nil {
+		plugin.Logger(ctx).Error("gcp_osconfig_patch_deployment.listOSConfigPatchDeployments", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getOSConfigPatchDeployment(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	name := d.EqualsQualString("name")
+
+	if name == "" {
+		return nil, nil
+	}
+
+	// Create service connection
+	service, err := OSConfigService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_osconfig_patch_deployment.getOSConfigPatchDeployment", "service_error", err)
+		return nil, err
+	}
+
+	resp, err := service.Projects.PatchDeployments.Get(name).Do()
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_osconfig_patch_deployment.getOSConfigPatchDeployment", "api_error", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func osConfigAkas(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	name := d.Value.(string)
+	return []string{"gcp://osconfig.googleapis.com/" + name}, nil
+}
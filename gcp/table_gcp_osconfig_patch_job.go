@@ -0,0 +1,219 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	"google.golang.org/api/osconfig/v1"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpOSConfigPatchJob(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_osconfig_patch_job",
+		Description: "GCP OS Config Patch Job",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			Hydrate:    getOSConfigPatchJob,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listOSConfigPatchJobs,
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the patch job, in the format projects/{project_id}/patchJobs/{patch_job_id}.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "display_name",
+				Description: "The display name for this patch job.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "The description of the patch job.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state",
+				Description: "The current state of the patch job, such as STARTED, INSTANCE_LOOKUP, PATCHING, SUCCEEDED, COMPLETED_WITH_ERRORS, CANCELED or TIMED_OUT.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "patch_deployment",
+				Description: "The name of the patch deployment that created this patch job, if any.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "percent_complete",
+				Description: "The percentage of the VM instances that have completed the patch job.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "dry_run",
+				Description: "If true, the patch job does not apply any patches.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "duration",
+				Description: "The duration of the patch job, after which the patch job times out.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "error_message",
+				Description: "If this patch job failed, this message provides information about the failure.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "succeeded_instance_count",
+				Description: "The number of instances that have completed successfully.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("InstanceDetailsSummary.SucceededInstanceCount"),
+			},
+			{
+				Name:        "failed_instance_count",
+				Description: "The number of instances that failed.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("InstanceDetailsSummary.FailedInstanceCount"),
+			},
+			{
+				Name:        "create_time",
+				Description: "The time the patch job was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("CreateTime").NullIfZero(),
+			},
+			{
+				Name:        "update_time",
+				Description: "The time the patch job was last updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("UpdateTime").NullIfZero(),
+			},
+			{
+				Name:        "instance_details_summary",
+				Description: "A summary of the number of instances in each state of the patch job.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "instance_filter",
+				Description: "The VM instances to patch, selected by labels, zones, name prefixes or instance names.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "patch_config",
+				Description: "The patch configuration that is applied.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "rollout",
+				Description: "The rollout strategy of the patch job.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(extractLastPartSeparatedByBackslash),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Name").Transform(osConfigAkas),
+			},
+
+			// standard gcp columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromConstant("global"),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getProject,
+				Transform:   transform.FromValue(),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listOSConfigPatchJobs(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Max limit is set as per documentation
+	pageSize := types.Int64(100)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	// Create service connection
+	service, err := OSConfigService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_osconfig_patch_job.listOSConfigPatchJobs", "service_error", err)
+		return nil, err
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	resp := service.Projects.PatchJobs.List("projects/" + project).PageSize(*pageSize)
+	if err := resp.Pages(ctx, func(page *osconfig.ListPatchJobsResponse) error {
+		for _, item := range page.PatchJobs {
+			d.StreamListItem(ctx, item)
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				return nil
+			}
+		}
+		return nil
+	}); err != nil {
+		plugin.Logger(ctx).Error("gcp_osconfig_patch_job.listOSConfigPatchJobs", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getOSConfigPatchJob(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	name := d.EqualsQualString("name")
+
+	if name == "" {
+		return nil, nil
+	}
+
+	// Create service connection
+	service, err := OSConfigService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_osconfig_patch_job.getOSConfigPatchJob", "service_error", err)
+		return nil, err
+	}
+
+	resp, err := service.Projects.PatchJobs.Get(name).Do()
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_osconfig_patch_job.getOSConfigPatchJob", "api_error", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
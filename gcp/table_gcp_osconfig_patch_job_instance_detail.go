@@ -0,0 +1,161 @@
+package gcp
+
+import (
+	"context"
+	"strings"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	"google.golang.org/api/osconfig/v1"
+)
+
+type osConfigPatchJobInstanceDetailInfo = struct {
+	Detail   *osconfig.PatchJobInstanceDetails
+	PatchJob string
+}
+
+//// TABLE DEFINITION
+
+func tableGcpOSConfigPatchJobInstanceDetail(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_osconfig_patch_job_instance_detail",
+		Description: "GCP OS Config Patch Job Instance Detail",
+		List: &plugin.ListConfig{
+			Hydrate:       listOSConfigPatchJobInstanceDetails,
+			ParentHydrate: listOSConfigPatchJobs,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "patch_job", Require: plugin.Optional},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "patch_job",
+				Description: "The name of the patch job, in the format projects/{project_id}/patchJobs/{patch_job_id}.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("PatchJob"),
+			},
+			{
+				Name:        "name",
+				Description: "The instance name, in the format projects/{project_id}/zones/{zone}/instances/{instance}.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Detail.Name"),
+			},
+			{
+				Name:        "instance_name",
+				Description: "The name of the VM instance.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Detail.Name").Transform(lastPathElement),
+			},
+			{
+				Name:        "instance_system_id",
+				Description: "The unique identifier of the VM instance.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Detail.InstanceSystemId"),
+			},
+			{
+				Name:        "state",
+				Description: "The current state of the patch job on the instance, such as PENDING, APPLYING_PATCHES, SUCCEEDED, SUCCEEDED_REBOOT_REQUIRED, FAILED or NO_AGENT_DETECTED.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Detail.State"),
+			},
+			{
+				Name:        "failure_reason",
+				Description: "If the patch fails, this field provides the reason.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Detail.FailureReason"),
+			},
+			{
+				Name:        "attempt_count",
+				Description: "The number of times the agent attempted to apply the patch.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Detail.AttemptCount"),
+			},
+
+			// standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Detail.Name").Transform(lastPathElement),
+			},
+
+			// standard gcp columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(osConfigPatchJobInstanceDetailZone),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getProject,
+				Transform:   transform.FromValue(),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listOSConfigPatchJobInstanceDetails(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	patchJob := h.Item.(*osconfig.PatchJob)
+
+	// Minimize the API call with the given patch job
+	if d.EqualsQualString("patch_job") != "" && d.EqualsQualString("patch_job") != patchJob.Name {
+		return nil, nil
+	}
+
+	// Max limit is set as per documentation
+	pageSize := types.Int64(100)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	// Create service connection
+	service, err := OSConfigService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_osconfig_patch_job_instance_detail.listOSConfigPatchJobInstanceDetails", "service_error", err)
+		return nil, err
+	}
+
+	resp := service.Projects.PatchJobs.InstanceDetails.List(patchJob.Name).PageSize(*pageSize)
+	if err := resp.Pages(ctx, func(page *osconfig.ListPatchJobInstanceDetailsResponse) error {
+		for _, item := range page.PatchJobInstanceDetails {
+			d.StreamLeafListItem(ctx, osConfigPatchJobInstanceDetailInfo{item, patchJob.Name})
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				return nil
+			}
+		}
+		return nil
+	}); err != nil {
+		plugin.Logger(ctx).Error("gcp_osconfig_patch_job_instance_detail.listOSConfigPatchJobInstanceDetails", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func osConfigPatchJobInstanceDetailZone(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	data := d.HydrateItem.(osConfigPatchJobInstanceDetailInfo)
+
+	// projects/{project_id}/zones/{zone}/instances/{instance}
+	splitName := strings.Split(data.Detail.Name, "/")
+	if len(splitName) < 4 {
+		return nil, nil
+	}
+
+	return splitName[3], nil
+}
@@ -0,0 +1,215 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	"google.golang.org/api/osconfig/v1"
+)
+
+type osConfigVulnerabilityInfo = struct {
+	Report        *osconfig.VulnerabilityReport
+	Vulnerability *osconfig.VulnerabilityReportVulnerability
+}
+
+//// TABLE DEFINITION
+
+func tableGcpOSConfigVulnerabilityReport(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_osconfig_vulnerability_report",
+		Description: "GCP OS Config Vulnerability Report",
+		List: &plugin.ListConfig{
+			Hydrate: listOSConfigVulnerabilityReports,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "instance_id", Require: plugin.Optional},
+				{Name: "location", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: BuildComputeZoneList,
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the vulnerability report, in the format projects/{project_number}/locations/{zone}/instances/{instance_id}/vulnerabilityReport.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Report.Name"),
+			},
+			{
+				Name:        "instance_id",
+				Description: "The unique identifier of the VM instance that the vulnerability was found on.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromP(osConfigVulnerabilityReportTurbotData, "InstanceId"),
+			},
+			{
+				Name:        "cve",
+				Description: "The CVE of the vulnerability, e.g. CVE-2021-44228.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Vulnerability.Details.Cve"),
+			},
+			{
+				Name:        "severity",
+				Description: "The assigned severity or impact ranking of the vulnerability, such as LOW, MEDIUM, HIGH or CRITICAL.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Vulnerability.Details.Severity"),
+			},
+			{
+				Name:        "cvss_v2_score",
+				Description: "The CVSS V2 score of the vulnerability.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("Vulnerability.Details.CvssV2Score"),
+			},
+			{
+				Name:        "cvss_v3_base_score",
+				Description: "The CVSS V3 base score of the vulnerability.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("Vulnerability.Details.CvssV3.BaseScore"),
+			},
+			{
+				Name:        "description",
+				Description: "The note or additional details for the vulnerability.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Vulnerability.Details.Description"),
+			},
+			{
+				Name:        "create_time",
+				Description: "The time the vulnerability was first detected on the VM.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Vulnerability.CreateTime").NullIfZero(),
+			},
+			{
+				Name:        "update_time",
+				Description: "The time the vulnerability was last updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Vulnerability.UpdateTime").NullIfZero(),
+			},
+			{
+				Name:        "report_update_time",
+				Description: "The time the vulnerability report was last generated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Report.UpdateTime").NullIfZero(),
+			},
+			{
+				Name:        "available_inventory_item_ids",
+				Description: "The IDs of the inventory items of the available packages that fix the vulnerability. The IDs match the keys of the items column of gcp_osconfig_inventory.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Vulnerability.AvailableInventoryItemIds"),
+			},
+			{
+				Name:        "cvss_v3",
+				Description: "The full description of the CVSS V3 score of the vulnerability.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Vulnerability.Details.CvssV3"),
+			},
+			{
+				Name:        "installed_inventory_item_ids",
+				Description: "The IDs of the inventory items of the installed packages that are affected by the vulnerability.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Vulnerability.InstalledInventoryItemIds"),
+			},
+			{
+				Name:        "items",
+				Description: "The affected packages of the VM, including the installed package, the fixed package CPE URI and the upstream fix version.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Vulnerability.Items"),
+			},
+			{
+				Name:        "references",
+				Description: "The corresponding references to the vulnerability, such as advisories and the NVD entry.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Vulnerability.Details.References"),
+			},
+
+			// standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Vulnerability.Details.Cve"),
+			},
+
+			// standard gcp columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(osConfigVulnerabilityReportTurbotData, "Location"),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getProject,
+				Transform:   transform.FromValue(),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listOSConfigVulnerabilityReports(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var location string
+	matrixLocation := d.EqualsQualString(matrixKeyLocation)
+	// Since, when the service API is disabled, matrixLocation value will be nil
+	if matrixLocation != "" {
+		location = matrixLocation
+	}
+
+	// Minimize the API call with given location
+	if d.EqualsQualString("location") != "" && d.EqualsQualString("location") != location {
+		return nil, nil
+	}
+
+	// Reports can hold many vulnerabilities, so the page size is not reduced
+	// by the limit
+	pageSize := types.Int64(100)
+
+	// Create service connection
+	service, err := OSConfigService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_osconfig_vulnerability_report.listOSConfigVulnerabilityReports", "service_error", err)
+		return nil, err
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	parent := "projects/" + project + "/locations/" + location + "/instances/" + osConfigInstanceParent(d)
+
+	resp := service.Projects.Locations.Instances.VulnerabilityReports.List(parent).PageSize(*pageSize)
+	if err := resp.Pages(ctx, func(page *osconfig.ListVulnerabilityReportsResponse) error {
+		for _, report := range page.VulnerabilityReports {
+			for _, vulnerability := range report.Vulnerabilities {
+				d.StreamListItem(ctx, osConfigVulnerabilityInfo{report, vulnerability})
+
+				// Check if context has been cancelled or if the limit has been hit (if specified)
+				// if there is a limit, it will return the number of rows required to reach this limit
+				if d.RowsRemaining(ctx) == 0 {
+					page.NextPageToken = ""
+					return nil
+				}
+			}
+		}
+		return nil
+	}); err != nil {
+		plugin.Logger(ctx).Error("gcp_osconfig_vulnerability_report.listOSConfigVulnerabilityReports", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func osConfigVulnerabilityReportTurbotData(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	data := d.HydrateItem.(osConfigVulnerabilityInfo)
+	param := d.Param.(string)
+
+	return osConfigInstanceResourceTurbotData(data.Report.Name)[param], nil
+}
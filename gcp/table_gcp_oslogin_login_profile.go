@@ -0,0 +1,167 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	"google.golang.org/api/oslogin/v1"
+)
+
+type osLoginLoginProfileInfo = struct {
+	Profile        *oslogin.LoginProfile
+	PrimaryAccount *oslogin.PosixAccount
+	User           string
+}
+
+//// TABLE DEFINITION
+
+func tableGcpOSLoginLoginProfile(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_oslogin_login_profile",
+		Description: "GCP OS Login Login Profile",
+		List: &plugin.ListConfig{
+			Hydrate: listOSLoginLoginProfiles,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "user", Require: plugin.Required},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "user",
+				Description: "The email address of the user or service account that the login profile belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "name",
+				Description: "The primary email address that uniquely identifies the user.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Profile.Name"),
+			},
+			{
+				Name:        "username",
+				Description: "The POSIX username of the user on the VMs of the project.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("PrimaryAccount.Username"),
+			},
+			{
+				Name:        "uid",
+				Description: "The user ID of the POSIX account.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("PrimaryAccount.Uid"),
+			},
+			{
+				Name:        "gid",
+				Description: "The default group ID of the POSIX account.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("PrimaryAccount.Gid"),
+			},
+			{
+				Name:        "home_directory",
+				Description: "The path to the home directory of the POSIX account.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("PrimaryAccount.HomeDirectory"),
+			},
+			{
+				Name:        "shell",
+				Description: "The path to the login shell of the POSIX account.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("PrimaryAccount.Shell"),
+			},
+			{
+				Name:        "ssh_public_key_count",
+				Description: "The number of SSH public keys associated with the user.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.From(osLoginLoginProfileSshPublicKeyCount),
+			},
+			{
+				Name:        "posix_accounts",
+				Description: "The list of POSIX accounts associated with the user.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Profile.PosixAccounts"),
+			},
+			{
+				Name:        "ssh_public_keys",
+				Description: "The SSH public keys associated with the user, keyed by fingerprint, including their expiration time.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Profile.SshPublicKeys"),
+			},
+
+			// standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("User"),
+			},
+
+			// standard gcp columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromConstant("global"),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getProject,
+				Transform:   transform.FromValue(),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listOSLoginLoginProfiles(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	user := d.EqualsQualString("user")
+
+	// Empty check
+	if user == "" {
+		return nil, nil
+	}
+
+	// Create service connection
+	service, err := OSLoginService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_oslogin_login_profile.listOSLoginLoginProfiles", "service_error", err)
+		return nil, err
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	// The POSIX accounts are returned for the given project
+	resp, err := service.Users.GetLoginProfile("users/" + user).ProjectId(project).Do()
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_oslogin_login_profile.listOSLoginLoginProfiles", "api_error", err)
+		return nil, err
+	}
+
+	// The primary POSIX account is the one used on the VMs of the project
+	var primaryAccount *oslogin.PosixAccount
+	for _, account := range resp.PosixAccounts {
+		if account.Primary {
+			primaryAccount = account
+			break
+		}
+	}
+
+	d.StreamListItem(ctx, osLoginLoginProfileInfo{resp, primaryAccount, user})
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func osLoginLoginProfileSshPublicKeyCount(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	data := d.HydrateItem.(osLoginLoginProfileInfo)
+	return len(data.Profile.SshPublicKeys), nil
+}
@@ -0,0 +1,216 @@
+package gcp
+
+import (
+	"context"
+	"strings"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	"google.golang.org/api/privateca/v1"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpPrivateCACaPool(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_privateca_ca_pool",
+		Description: "GCP Certificate Authority Service CA Pool",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			Hydrate:    getPrivateCACaPool,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listPrivateCACaPools,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "location", Require: plugin.Optional, Operators: []string{"="}},
+			},
+		},
+		GetMatrixItemFunc: BuildPrivateCALocationList,
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The resource name of the CA pool, in the format projects/*/locations/*/caPools/*.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "tier",
+				Description: "The tier of the CA pool, either ENTERPRISE or DEVOPS.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "self_link",
+				Description: "The server-defined URL for the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(privateCACaPoolTurbotData, "SelfLink"),
+			},
+			{
+				Name:        "issuance_policy",
+				Description: "The IssuancePolicy to control how certificates will be issued from this CA pool.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "publishing_options",
+				Description: "The PublishingOptions to follow when issuing certificates from any CertificateAuthority in this CA pool.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "labels",
+				Description: "Labels with user-defined metadata.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(extractLastPartSeparatedByBackslash),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Labels"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromP(privateCACaPoolTurbotData, "Akas"),
+			},
+
+			// standard gcp columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(privateCACaPoolTurbotData, "Location"),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(privateCACaPoolTurbotData, "Project"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listPrivateCACaPools(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var location string
+	matrixLocation := d.EqualsQualString(matrixKeyLocation)
+	// Since, when the service API is disabled, matrixLocation value will be nil
+	if matrixLocation != "" {
+		location = matrixLocation
+	}
+
+	// Minimize the API call with given location
+	if d.EqualsQualString("location") != "" && d.EqualsQualString("location") != location {
+		return nil, nil
+	}
+
+	// Max limit is set as per documentation
+	pageSize := types.Int64(500)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	// Create service connection
+	service, err := PrivateCAService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_privateca_ca_pool.listPrivateCACaPools", "service_error", err)
+		return nil, err
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	parent := "projects/" + project + "/locations/" + location
+
+	resp := service.Projects.Locations.CaPools.List(parent).PageSize(*pageSize)
+	if err := resp.Pages(ctx, func(page *privateca.ListCaPoolsResponse) error {
+		for _, item := range page.CaPools {
+			d.StreamListItem(ctx, item)
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				return nil
+			}
+		}
+		return nil
+	}); err != nil {
+		plugin.Logger(ctx).Error("gcp_privateca_ca_pool.listPrivateCACaPools", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getPrivateCACaPool(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	matrixLocation := d.EqualsQualString(matrixKeyLocation)
+
+	name := d.EqualsQualString("name")
+
+	if name == "" {
+		return nil, nil
+	}
+
+	// Restrict the API call for other locations
+	if len(strings.Split(name, "/")) > 3 && strings.Split(name, "/")[3] != matrixLocation {
+		return nil, nil
+	}
+
+	// Create service connection
+	service, err := PrivateCAService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_privateca_ca_pool.getPrivateCACaPool", "service_error", err)
+		return nil, err
+	}
+
+	resp, err := service.Projects.Locations.CaPools.Get(name).Do()
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_privateca_ca_pool.getPrivateCACaPool", "api_error", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func privateCACaPoolTurbotData(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	data := d.HydrateItem.(*privateca.CaPool)
+	param := d.Param.(string)
+
+	return privateCAResourceTurbotData(data.Name)[param], nil
+}
+
+// privateCAResourceTurbotData returns the project, location, CA pool, self link
+// and akas of a Certificate Authority Service resource from its relative
+// resource name, e.g. projects/my-project/locations/us-central1/caPools/my-pool
+func privateCAResourceTurbotData(name string) map[string]interface{} {
+	splitName := strings.Split(name, "/")
+
+	return map[string]interface{}{
+		"Project":  splitName[1],
+		"Location": splitName[3],
+		"CaPool":   splitName[5],
+		"SelfLink": "https://privateca.googleapis.com/v1/" + name,
+		"Akas":     []string{"gcp://privateca.googleapis.com/" + name},
+	}
+}
@@ -0,0 +1,286 @@
+package gcp
+
+import (
+	"context"
+	"strings"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	"google.golang.org/api/privateca/v1"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpPrivateCACertificate(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_privateca_certificate",
+		Description: "GCP Certificate Authority Service Certificate",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			Hydrate:    getPrivateCACertificate,
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listPrivateCACaPools,
+			Hydrate:       listPrivateCACertificates,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "location", Require: plugin.Optional, Operators: []string{"="}},
+				{Name: "ca_pool_name", Require: plugin.Optional, Operators: []string{"="}},
+			},
+		},
+		GetMatrixItemFunc: BuildPrivateCALocationList,
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The resource name of the certificate, in the format projects/*/locations/*/caPools/*/certificates/*.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "ca_pool_name",
+				Description: "The name of the CA pool the certificate was issued from.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(privateCACertificateTurbotData, "CaPool"),
+			},
+			{
+				Name:        "issuer_certificate_authority",
+				Description: "The resource name of the issuing certificate authority, in the format projects/*/locations/*/caPools/*/certificateAuthorities/*.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "certificate_template",
+				Description: "The resource name for a certificate template used to issue this certificate.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "subject_mode",
+				Description: "Specifies how the certificate's identity fields are to be decided, either DEFAULT or REFLECTED_SPIFFE.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "lifetime",
+				Description: "The desired lifetime of the certificate, as a duration in seconds, e.g. 2592000s.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "hex_serial_number",
+				Description: "The serial number encoded in lowercase hexadecimal.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CertificateDescription.SubjectDescription.HexSerialNumber"),
+			},
+			{
+				Name:        "create_time",
+				Description: "The time at which the certificate was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("CreateTime").NullIfZero(),
+			},
+			{
+				Name:        "update_time",
+				Description: "The time at which the certificate was last updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("UpdateTime").NullIfZero(),
+			},
+			{
+				Name:        "not_before_time",
+				Description: "The time at which the certificate becomes valid.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("CertificateDescription.SubjectDescription.NotBeforeTime").NullIfZero(),
+			},
+			{
+				Name:        "not_after_time",
+				Description: "The time after which the certificate is expired.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("CertificateDescription.SubjectDescription.NotAfterTime").NullIfZero(),
+			},
+			{
+				Name:        "is_revoked",
+				Description: "True if the certificate has been revoked.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.From(privateCACertificateIsRevoked),
+			},
+			{
+				Name:        "revocation_state",
+				Description: "Indicates why the certificate was revoked, such as KEY_COMPROMISE or SUPERSEDED.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("RevocationDetails.RevocationState"),
+			},
+			{
+				Name:        "revocation_time",
+				Description: "The time at which the certificate was revoked.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("RevocationDetails.RevocationTime").NullIfZero(),
+			},
+			{
+				Name:        "self_link",
+				Description: "The server-defined URL for the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(privateCACertificateTurbotData, "SelfLink"),
+			},
+			{
+				Name:        "pem_certificate",
+				Description: "The pem-encoded, signed X.509 certificate.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "pem_certificate_chain",
+				Description: "The chain that may be used to verify the X.509 certificate.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "subject",
+				Description: "Contains distinguished name fields such as the common name, location and organization.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("CertificateDescription.SubjectDescription.Subject"),
+			},
+			{
+				Name:        "subject_alt_name",
+				Description: "The subject alternative name fields of the certificate.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("CertificateDescription.SubjectDescription.SubjectAltName"),
+			},
+			{
+				Name:        "certificate_description",
+				Description: "A structured description of the issued X.509 certificate.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "config",
+				Description: "The config used to create a certificate, if the certificate was not created from a CSR.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "labels",
+				Description: "Labels with user-defined metadata.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(extractLastPartSeparatedByBackslash),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Labels"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromP(privateCACertificateTurbotData, "Akas"),
+			},
+
+			// standard gcp columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(privateCACertificateTurbotData, "Location"),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(privateCACertificateTurbotData, "Project"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listPrivateCACertificates(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	caPool := h.Item.(*privateca.CaPool)
+
+	// Minimize the API call with given CA pool
+	caPoolName := d.EqualsQualString("ca_pool_name")
+	if caPoolName != "" && caPoolName != getLastPathElement(caPool.Name) {
+		return nil, nil
+	}
+
+	// Max limit is set as per documentation
+	pageSize := types.Int64(1000)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	// Create service connection
+	service, err := PrivateCAService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_privateca_certificate.listPrivateCACertificates", "service_error", err)
+		return nil, err
+	}
+
+	resp := service.Projects.Locations.CaPools.Certificates.List(caPool.Name).PageSize(*pageSize)
+	if err := resp.Pages(ctx, func(page *privateca.ListCertificatesResponse) error {
+		for _, item := range page.Certificates {
+			d.StreamListItem(ctx, item)
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				return nil
+			}
+		}
+		return nil
+	}); err != nil {
+		plugin.Logger(ctx).Error("gcp_privateca_certificate.listPrivateCACertificates", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getPrivateCACertificate(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	matrixLocation := d.EqualsQualString(matrixKeyLocation)
+
+	name := d.EqualsQualString("name")
+
+	if name == "" {
+		return nil, nil
+	}
+
+	// Restrict the API call for other locations
+	if len(strings.Split(name, "/")) > 3 && strings.Split(name, "/")[3] != matrixLocation {
+		return nil, nil
+	}
+
+	// Create service connection
+	service, err := PrivateCAService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_privateca_certificate.getPrivateCACertificate", "service_error", err)
+		return nil, err
+	}
+
+	resp, err := service.Projects.Locations.CaPools.Certificates.Get(name).Do()
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_privateca_certificate.getPrivateCACertificate", "api_error", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func privateCACertificateIsRevoked(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	data := d.HydrateItem.(*privateca.Certificate)
+	return data.RevocationDetails != nil, nil
+}
+
+func privateCACertificateTurbotData(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	data := d.HydrateItem.(*privateca.Certificate)
+	param := d.Param.(string)
+
+	return privateCAResourceTurbotData(data.Name)[param], nil
+}
@@ -0,0 +1,300 @@
+package gcp
+
+import (
+	"context"
+	"strings"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	"google.golang.org/api/privateca/v1"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpPrivateCACertificateAuthority(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_privateca_certificate_authority",
+		Description: "GCP Certificate Authority Service Certificate Authority",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			Hydrate:    getPrivateCACertificateAuthority,
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listPrivateCACaPools,
+			Hydrate:       listPrivateCACertificateAuthorities,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "location", Require: plugin.Optional, Operators: []string{"="}},
+				{Name: "ca_pool_name", Require: plugin.Optional, Operators: []string{"="}},
+			},
+		},
+		GetMatrixItemFunc: BuildPrivateCALocationList,
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The resource name of the certificate authority, in the format projects/*/locations/*/caPools/*/certificateAuthorities/*.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "ca_pool_name",
+				Description: "The name of the CA pool the certificate authority belongs to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(privateCACertificateAuthorityTurbotData, "CaPool"),
+			},
+			{
+				Name:        "state",
+				Description: "The state of the certificate authority, such as ENABLED, DISABLED, STAGED, AWAITING_USER_ACTIVATION, DELETED or TIER_DOWNGRADE_PENDING.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "type",
+				Description: "The type of the certificate authority, either SELF_SIGNED or SUBORDINATE.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "tier",
+				Description: "The tier of the CA pool the certificate authority belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "lifetime",
+				Description: "The desired lifetime of the CA certificate, as a duration in seconds, e.g. 315360000s.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "create_time",
+				Description: "The time at which the certificate authority was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("CreateTime").NullIfZero(),
+			},
+			{
+				Name:        "update_time",
+				Description: "The time at which the certificate authority was last updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("UpdateTime").NullIfZero(),
+			},
+			{
+				Name:        "delete_time",
+				Description: "The time at which the certificate authority was soft deleted, if it is in the DELETED state.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("DeleteTime").NullIfZero(),
+			},
+			{
+				Name:        "expire_time",
+				Description: "The time at which the certificate authority will be permanently purged, if it is in the DELETED state.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("ExpireTime").NullIfZero(),
+			},
+			{
+				Name:        "not_before_time",
+				Description: "The time at which the CA certificate becomes valid.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromP(privateCACertificateAuthorityValidity, "NotBeforeTime").NullIfZero(),
+			},
+			{
+				Name:        "not_after_time",
+				Description: "The time after which the CA certificate is expired.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromP(privateCACertificateAuthorityValidity, "NotAfterTime").NullIfZero(),
+			},
+			{
+				Name:        "gcs_bucket",
+				Description: "The name of a Cloud Storage bucket where the certificate authority will publish content, such as the CA certificate and CRLs.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "satisfies_pzs",
+				Description: "Reserved for future use.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "satisfies_pzi",
+				Description: "Reserved for future use.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "self_link",
+				Description: "The server-defined URL for the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(privateCACertificateAuthorityTurbotData, "SelfLink"),
+			},
+			{
+				Name:        "access_urls",
+				Description: "URLs for accessing content published by this CA, such as the CA certificate and CRLs.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "ca_certificate_descriptions",
+				Description: "A structured description of this certificate authority's certificate and its issuers, ordered from self-to-root.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "config",
+				Description: "The config used to create a self-signed X.509 certificate or CSR.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "key_spec",
+				Description: "Used when issuing certificates for this certificate authority.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "pem_ca_certificates",
+				Description: "This certificate authority's certificate chain, including the current certificate authority's certificate.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "subordinate_config",
+				Description: "If this is a subordinate certificate authority, this field will be set with the subordinate configuration.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "labels",
+				Description: "Labels with user-defined metadata.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(extractLastPartSeparatedByBackslash),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Labels"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromP(privateCACertificateAuthorityTurbotData, "Akas"),
+			},
+
+			// standard gcp columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(privateCACertificateAuthorityTurbotData, "Location"),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(privateCACertificateAuthorityTurbotData, "Project"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listPrivateCACertificateAuthorities(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	caPool := h.Item.(*privateca.CaPool)
+
+	// Minimize the API call with given CA pool
+	caPoolName := d.EqualsQualString("ca_pool_name")
+	if caPoolName != "" && caPoolName != getLastPathElement(caPool.Name) {
+		return nil, nil
+	}
+
+	// Max limit is set as per documentation
+	pageSize := types.Int64(500)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	// Create service connection
+	service, err := PrivateCAService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_privateca_certificate_authority.listPrivateCACertificateAuthorities", "service_error", err)
+		return nil, err
+	}
+
+	resp := service.Projects.Locations.CaPools.CertificateAuthorities.List(caPool.Name).PageSize(*pageSize)
+	if err := resp.Pages(ctx, func(page *privateca.ListCertificateAuthoritiesResponse) error {
+		for _, item := range page.CertificateAuthorities {
+			d.StreamListItem(ctx, item)
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				return nil
+			}
+		}
+		return nil
+	}); err != nil {
+		plugin.Logger(ctx).Error("gcp_privateca_certificate_authority.listPrivateCACertificateAuthorities", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getPrivateCACertificateAuthority(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	matrixLocation := d.EqualsQualString(matrixKeyLocation)
+
+	name := d.EqualsQualString("name")
+
+	if name == "" {
+		return nil, nil
+	}
+
+	// Restrict the API call for other locations
+	if len(strings.Split(name, "/")) > 3 && strings.Split(name, "/")[3] != matrixLocation {
+		return nil, nil
+	}
+
+	// Create service connection
+	service, err := PrivateCAService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_privateca_certificate_authority.getPrivateCACertificateAuthority", "service_error", err)
+		return nil, err
+	}
+
+	resp, err := service.Projects.Locations.CaPools.CertificateAuthorities.Get(name).Do()
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_privateca_certificate_authority.getPrivateCACertificateAuthority", "api_error", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+// The first CA certificate description is the certificate authority's own certificate
+func privateCACertificateAuthorityValidity(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	data := d.HydrateItem.(*privateca.CertificateAuthority)
+	param := d.Param.(string)
+
+	if len(data.CaCertificateDescriptions) == 0 || data.CaCertificateDescriptions[0].SubjectDescription == nil {
+		return nil, nil
+	}
+	subjectDescription := data.CaCertificateDescriptions[0].SubjectDescription
+
+	validity := map[string]string{
+		"NotBeforeTime": subjectDescription.NotBeforeTime,
+		"NotAfterTime":  subjectDescription.NotAfterTime,
+	}
+
+	return validity[param], nil
+}
+
+func privateCACertificateAuthorityTurbotData(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	data := d.HydrateItem.(*privateca.CertificateAuthority)
+	param := d.Param.(string)
+
+	return privateCAResourceTurbotData(data.Name)[param], nil
+}
@@ -0,0 +1,237 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpQuotaInfo(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_quota_info",
+		Description: "GCP Cloud Quotas Quota Info",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			Hydrate:    getQuotaInfo,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listQuotaInfos,
+			KeyColumns: plugin.KeyColumnSlice{
+				// String columns
+				{Name: "service", Require: plugin.Required},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The resource name of the quota info, in the format projects/{project}/locations/global/services/{service}/quotaInfos/{quota_id}.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "quota_id",
+				Description: "The ID of the quota, which is unique within the service, e.g. CpusPerProjectPerRegion.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "service",
+				Description: "The name of the service in which the quota is defined, e.g. compute.googleapis.com.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "metric",
+				Description: "The metric of the quota, which specifies the resources being measured, e.g. compute.googleapis.com/cpus.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "metric_display_name",
+				Description: "The display name of the quota metric.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "quota_display_name",
+				Description: "The display name of the quota.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "metric_unit",
+				Description: "The unit in which the metric value is reported, e.g. 1/min/{project}.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "container_type",
+				Description: "The container type of the quota info, either PROJECT, FOLDER or ORGANIZATION.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "refresh_interval",
+				Description: "The reset time interval for the quota. Empty for quotas that are not rate quotas.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "is_precise",
+				Description: "Whether this is a precise quota. A precise quota is tracked with absolute precision.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "is_fixed",
+				Description: "Whether the quota value is fixed or adjustable.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "is_concurrent",
+				Description: "Whether the quota is a concurrent quota. Concurrent quotas are enforced on the total number of concurrent operations in flight.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "is_eligible_for_increase",
+				Description: "Whether a quota increase can be requested for this quota.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("QuotaIncreaseEligibility.IsEligible"),
+			},
+			{
+				Name:        "ineligibility_reason",
+				Description: "The reason the quota is not eligible for a quota increase, if any.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("QuotaIncreaseEligibility.IneligibilityReason"),
+			},
+			{
+				Name:        "service_request_quota_uri",
+				Description: "The URI to request a quota increase for this quota, for services that handle quota increases themselves.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "dimensions",
+				Description: "The dimensions the quota is defined on, e.g. region or zone.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "dimensions_infos",
+				Description: "The collection of dimensions info ordered by their dimensions from more specific ones to less specific ones, including the quota value that applies to each set of dimensions.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("QuotaId"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Name").Transform(cloudQuotasAkas),
+			},
+
+			// standard gcp columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromConstant("global"),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getProject,
+				Transform:   transform.FromValue(),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listQuotaInfos(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	serviceName := d.EqualsQualString("service")
+
+	// Max limit is set as per documentation
+	pageSize := types.Int64(1000)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	// Create service connection
+	service, err := CloudQuotasService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_quota_info.listQuotaInfos", "service_error", err)
+		return nil, err
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	parent := "projects/" + project + "/locations/global/services/" + serviceName
+
+	pageToken := ""
+	for {
+		resp, err := service.listQuotaInfos(ctx, parent, *pageSize, pageToken)
+		if err != nil {
+			plugin.Logger(ctx).Error("gcp_quota_info.listQuotaInfos", "api_error", err)
+			return nil, err
+		}
+
+		for _, item := range resp.QuotaInfos {
+			d.StreamListItem(ctx, item)
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if resp.NextPageToken == "" {
+			break
+		}
+		pageToken = resp.NextPageToken
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getQuotaInfo(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	name := d.EqualsQualString("name")
+
+	if name == "" {
+		return nil, nil
+	}
+
+	// Create service connection
+	service, err := CloudQuotasService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_quota_info.getQuotaInfo", "service_error", err)
+		return nil, err
+	}
+
+	resp, err := service.getQuotaInfo(ctx, name)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_quota_info.getQuotaInfo", "api_error", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func cloudQuotasAkas(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	name := d.Value.(string)
+	return []string{"gcp://cloudquotas.googleapis.com/" + name}, nil
+}
@@ -0,0 +1,224 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpQuotaPreference(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_quota_preference",
+		Description: "GCP Cloud Quotas Quota Preference",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			Hydrate:    getQuotaPreference,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listQuotaPreferences,
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The resource name of the quota preference, in the format projects/{project}/locations/global/quotaPreferences/{quota_preference_id}.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "service",
+				Description: "The name of the service to which the quota preference is applied, e.g. compute.googleapis.com.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "quota_id",
+				Description: "The ID of the quota to which the quota preference is applied, e.g. CpusPerProjectPerRegion.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "preferred_value",
+				Description: "The preferred value of the quota, as requested.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("QuotaConfig.PreferredValue"),
+			},
+			{
+				Name:        "granted_value",
+				Description: "The granted quota value following the quota preference.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("QuotaConfig.GrantedValue"),
+			},
+			{
+				Name:        "state_detail",
+				Description: "Optional details about the state of the quota preference.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("QuotaConfig.StateDetail"),
+			},
+			{
+				Name:        "request_origin",
+				Description: "The origin of the quota preference request, such as CLOUD_CONSOLE or AUTO_ADJUSTER.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("QuotaConfig.RequestOrigin"),
+			},
+			{
+				Name:        "trace_id",
+				Description: "The trace ID that can be used to investigate the quota preference with Google support.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("QuotaConfig.TraceId"),
+			},
+			{
+				Name:        "reconciling",
+				Description: "Whether the quota preference is pending a decision on the requested value.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "justification",
+				Description: "The reason for the quota preference, as provided by the requester.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "contact_email",
+				Description: "The email address of the person to contact about the quota preference.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "etag",
+				Description: "The etag of the quota preference, used for optimistic concurrency control.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "create_time",
+				Description: "The time the quota preference was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("CreateTime").NullIfZero(),
+			},
+			{
+				Name:        "update_time",
+				Description: "The time the quota preference was last updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("UpdateTime").NullIfZero(),
+			},
+			{
+				Name:        "dimensions",
+				Description: "The dimensions that the quota preference applies to, e.g. region or zone.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "quota_config",
+				Description: "The preferred quota configuration, including the preferred and granted values.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(extractLastPartSeparatedByBackslash),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Name").Transform(cloudQuotasAkas),
+			},
+
+			// standard gcp columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromConstant("global"),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getProject,
+				Transform:   transform.FromValue(),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listQuotaPreferences(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Max limit is set as per documentation
+	pageSize := types.Int64(1000)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	// Create service connection
+	service, err := CloudQuotasService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_quota_preference.listQuotaPreferences", "service_error", err)
+		return nil, err
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	parent := "projects/" + project + "/locations/global"
+
+	pageToken := ""
+	for {
+		resp, err := service.listQuotaPreferences(ctx, parent, *pageSize, pageToken)
+		if err != nil {
+			plugin.Logger(ctx).Error("gcp_quota_preference.listQuotaPreferences", "api_error", err)
+			return nil, err
+		}
+
+		for _, item := range resp.QuotaPreferences {
+			d.StreamListItem(ctx, item)
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if resp.NextPageToken == "" {
+			break
+		}
+		pageToken = resp.NextPageToken
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getQuotaPreference(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	name := d.EqualsQualString("name")
+
+	if name == "" {
+		return nil, nil
+	}
+
+	// Create service connection
+	service, err := CloudQuotasService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_quota_preference.getQuotaPreference", "service_error", err)
+		return nil, err
+	}
+
+	resp, err := service.getQuotaPreference(ctx, name)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_quota_preference.getQuotaPreference", "api_error", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,147 @@
+package gcp
+
+import (
+	"context"
+	"strings"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"google.golang.org/api/compute/v1"
+	"google.golang.org/api/servicenetworking/v1"
+)
+
+type serviceNetworkingConnectionInfo = struct {
+	Connection *servicenetworking.Connection
+	Network    *compute.Network
+}
+
+//// TABLE DEFINITION
+
+func tableGcpServiceNetworkingConnection(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_service_networking_connection",
+		Description: "GCP Service Networking Connection",
+		List: &plugin.ListConfig{
+			Hydrate:       listServiceNetworkingConnections,
+			ParentHydrate: listComputeNetworks,
+			KeyColumns: plugin.KeyColumnSlice{
+				// String columns
+				{Name: "network_name", Require: plugin.Optional},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "service",
+				Description: "The name of the peering service that's associated with this connection, e.g. services/servicenetworking.googleapis.com.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Connection.Service"),
+			},
+			{
+				Name:        "peering",
+				Description: "The name of the VPC Network Peering connection that was created by the service producer.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Connection.Peering"),
+			},
+			{
+				Name:        "network_name",
+				Description: "The name of the consumer VPC network that is connected to the service producer network.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Network.Name"),
+			},
+			{
+				Name:        "network",
+				Description: "The consumer VPC network in the format projects/{project_number}/global/networks/{network}.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Connection.Network"),
+			},
+			{
+				Name:        "network_self_link",
+				Description: "Server-defined URL of the consumer VPC network.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Network.SelfLink"),
+			},
+			{
+				Name:        "reserved_peering_ranges",
+				Description: "The names of the allocated IP address ranges for this private service access connection.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Connection.ReservedPeeringRanges"),
+			},
+
+			// standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Connection.Peering"),
+			},
+
+			// standard gcp columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromConstant("global"),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(serviceNetworkingConnectionProject),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listServiceNetworkingConnections(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	network := h.Item.(*compute.Network)
+
+	// Minimize the API call with the given network
+	networkName := d.EqualsQualString("network_name")
+	if networkName != "" && networkName != network.Name {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := ServiceNetworkingService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_service_networking_connection.listServiceNetworkingConnections", "service_error", err)
+		return nil, err
+	}
+
+	// The network must be identified by the project number
+	projectNumber, err := getProjectNumber(ctx, d, h)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_service_networking_connection.listServiceNetworkingConnections", "project_number_error", err)
+		return nil, err
+	}
+
+	// services/- lists the connections of all the peering services
+	resp, err := service.Services.Connections.List("services/-").Network("projects/" + projectNumber.(string) + "/global/networks/" + network.Name).Do()
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_service_networking_connection.listServiceNetworkingConnections", "api_error", err)
+		return nil, err
+	}
+
+	for _, connection := range resp.Connections {
+		d.StreamLeafListItem(ctx, serviceNetworkingConnectionInfo{connection, network})
+
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func serviceNetworkingConnectionProject(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	data := d.HydrateItem.(serviceNetworkingConnectionInfo)
+	return strings.Split(data.Network.SelfLink, "/")[6], nil
+}
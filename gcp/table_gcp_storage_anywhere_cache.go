@@ -0,0 +1,205 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	"google.golang.org/api/storage/v1"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpStorageAnywhereCache(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_storage_anywhere_cache",
+		Description: "GCP Storage Anywhere Cache",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"bucket", "anywhere_cache_id"}),
+			Hydrate:    getStorageAnywhereCache,
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listGcpStorageBuckets,
+			Hydrate:       listStorageAnywhereCaches,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "bucket", Require: plugin.Optional},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "anywhere_cache_id",
+				Description: "The ID of the anywhere cache, which is the zone it is created in.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "bucket",
+				Description: "The name of the bucket containing the anywhere cache.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the resource, including the bucket name and anywhere cache ID.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state",
+				Description: "The current state of the cache, e.g. RUNNING, CREATING, PAUSED or DISABLED.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "zone",
+				Description: "The zone in which the cache instance is running, e.g. us-central1-a.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "admission_policy",
+				Description: "The cache admission policy, either admit-on-first-miss or admit-on-second-miss.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "ttl",
+				Description: "The TTL of all cache entries, as a duration in seconds, e.g. 86400s.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "pending_update",
+				Description: "True if the cache has a pending update.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "create_time",
+				Description: "The creation time of the cache instance.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("CreateTime").NullIfZero(),
+			},
+			{
+				Name:        "update_time",
+				Description: "The modification time of the cache instance metadata.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("UpdateTime").NullIfZero(),
+			},
+			{
+				Name:        "kind",
+				Description: "The kind of item this is. For anywhere caches, this is always storage#anywhereCache.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "self_link",
+				Description: "The link to this cache instance.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Id"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.From(storageAnywhereCacheAkas),
+			},
+
+			// standard gcp columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Zone"),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getProject,
+				Transform:   transform.FromValue(),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listStorageAnywhereCaches(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	bucket := h.Item.(*storage.Bucket)
+
+	// Minimize the API call with given bucket
+	if d.EqualsQualString("bucket") != "" && d.EqualsQualString("bucket") != bucket.Name {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := StorageService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_storage_anywhere_cache.listStorageAnywhereCaches", "service_error", err)
+		return nil, err
+	}
+
+	// Max limit is set as per documentation
+	pageSize := types.Int64(1000)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	resp := service.AnywhereCaches.List(bucket.Name).PageSize(*pageSize)
+	if err := resp.Pages(ctx, func(page *storage.AnywhereCaches) error {
+		for _, cache := range page.Items {
+			d.StreamListItem(ctx, cache)
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				return nil
+			}
+		}
+		return nil
+	}); err != nil {
+		plugin.Logger(ctx).Error("gcp_storage_anywhere_cache.listStorageAnywhereCaches", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getStorageAnywhereCache(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	bucket := d.EqualsQualString("bucket")
+	id := d.EqualsQualString("anywhere_cache_id")
+
+	// Return nil, if no input provided
+	if bucket == "" || id == "" {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := StorageService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_storage_anywhere_cache.getStorageAnywhereCache", "service_error", err)
+		return nil, err
+	}
+
+	resp, err := service.AnywhereCaches.Get(bucket, id).Do()
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_storage_anywhere_cache.getStorageAnywhereCache", "api_error", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func storageAnywhereCacheAkas(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	cache := d.HydrateItem.(*storage.AnywhereCache)
+	return []string{"gcp://storage.googleapis.com/buckets/" + cache.Bucket + "/anywhereCaches/" + cache.AnywhereCacheId}, nil
+}
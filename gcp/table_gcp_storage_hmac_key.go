@@ -0,0 +1,204 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	"google.golang.org/api/storage/v1"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpStorageHmacKey(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_storage_hmac_key",
+		Description: "GCP Storage HMAC Key",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("access_id"),
+			Hydrate:    getStorageHmacKey,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listStorageHmacKeys,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "service_account_email", Require: plugin.Optional},
+				{Name: "state", Require: plugin.Optional},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "access_id",
+				Description: "The access ID of the HMAC key, used as the access key in requests signed with the key.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the HMAC key, including the project ID and the access ID.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "service_account_email",
+				Description: "The email address of the service account the key authenticates as.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state",
+				Description: "The state of the key, either ACTIVE, INACTIVE or DELETED. Deleted keys are only listed if the state is specified in the where clause.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "time_created",
+				Description: "The creation time of the HMAC key.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromGo().NullIfZero(),
+			},
+			{
+				Name:        "updated",
+				Description: "The last modification time of the HMAC key metadata.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromGo().NullIfZero(),
+			},
+			{
+				Name:        "etag",
+				Description: "HTTP 1.1 Entity tag for the HMAC key.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "kind",
+				Description: "The kind of item this is. For HMAC Key metadata, this is always storage#hmacKeyMetadata.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "self_link",
+				Description: "The link to this resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AccessId"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.From(storageHmacKeyAkas),
+			},
+
+			// standard gcp columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromConstant("global"),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ProjectId"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listStorageHmacKeys(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Create Service Connection
+	service, err := StorageService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_storage_hmac_key.listStorageHmacKeys", "service_error", err)
+		return nil, err
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	// Max limit isn't mentioned in the documentation
+	// Default limit is set as 1000
+	maxResults := types.Int64(1000)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *maxResults {
+			maxResults = limit
+		}
+	}
+
+	resp := service.Projects.HmacKeys.List(project).MaxResults(*maxResults)
+	if email := d.EqualsQualString("service_account_email"); email != "" {
+		resp.ServiceAccountEmail(email)
+	}
+	// Deleted keys are only returned on request
+	if d.EqualsQualString("state") == "DELETED" {
+		resp.ShowDeletedKeys(true)
+	}
+
+	if err := resp.Pages(ctx, func(page *storage.HmacKeysMetadata) error {
+		for _, key := range page.Items {
+			d.StreamListItem(ctx, key)
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				return nil
+			}
+		}
+		return nil
+	}); err != nil {
+		plugin.Logger(ctx).Error("gcp_storage_hmac_key.listStorageHmacKeys", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getStorageHmacKey(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	accessId := d.EqualsQualString("access_id")
+
+	// Return nil, if no input provided
+	if accessId == "" {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := StorageService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_storage_hmac_key.getStorageHmacKey", "service_error", err)
+		return nil, err
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	resp, err := service.Projects.HmacKeys.Get(project, accessId).Do()
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_storage_hmac_key.getStorageHmacKey", "api_error", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func storageHmacKeyAkas(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	key := d.HydrateItem.(*storage.HmacKeyMetadata)
+	return []string{"gcp://storage.googleapis.com/projects/" + key.ProjectId + "/hmacKeys/" + key.AccessId}, nil
+}
@@ -0,0 +1,281 @@
+package gcp
+
+import (
+	"context"
+	"strings"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpStorageInsightsReportConfig(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_storage_insights_report_config",
+		Description: "GCP Storage Insights Report Config",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			Hydrate:    getStorageInsightsReportConfig,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listStorageInsightsReportConfigs,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "location", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: BuildStorageInsightsLocationList,
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the report config, in the format projects/{project}/locations/{location}/reportConfigs/{report_config_id}.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "display_name",
+				Description: "The user-provided name of the report config.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "format",
+				Description: "The format of the inventory reports, either CSV or PARQUET.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(storageInsightsReportConfigFormat),
+			},
+			{
+				Name:        "frequency",
+				Description: "How often the inventory reports are generated, either DAILY or WEEKLY.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("FrequencyOptions.Frequency"),
+			},
+			{
+				Name:        "source_bucket",
+				Description: "The bucket the inventory reports are generated for.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ObjectMetadataReportOptions.StorageFilters.Bucket"),
+			},
+			{
+				Name:        "destination_bucket",
+				Description: "The bucket the inventory reports are written to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ObjectMetadataReportOptions.StorageDestinationOptions.Bucket"),
+			},
+			{
+				Name:        "destination_path",
+				Description: "The path within the destination bucket the inventory reports are written to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ObjectMetadataReportOptions.StorageDestinationOptions.DestinationPath"),
+			},
+			{
+				Name:        "create_time",
+				Description: "The time the report config was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("CreateTime").NullIfZero(),
+			},
+			{
+				Name:        "update_time",
+				Description: "The time the report config was last updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("UpdateTime").NullIfZero(),
+			},
+			{
+				Name:        "self_link",
+				Description: "The server-defined URL for the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(storageInsightsReportConfigTurbotData, "SelfLink"),
+			},
+			{
+				Name:        "csv_options",
+				Description: "The options of the CSV formatted reports, including the delimiter, the record separator and whether a header row is included.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "frequency_options",
+				Description: "The schedule of the reports, including the frequency and the start and end dates.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "metadata_fields",
+				Description: "The object metadata fields included in the inventory reports, in the order of the report columns.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ObjectMetadataReportOptions.MetadataFields"),
+			},
+			{
+				Name:        "labels",
+				Description: "Labels as key value pairs.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(storageInsightsReportConfigTitle),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Labels"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromP(storageInsightsReportConfigTurbotData, "Akas"),
+			},
+
+			// standard gcp columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(storageInsightsReportConfigTurbotData, "Location"),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getProject,
+				Transform:   transform.FromValue(),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listStorageInsightsReportConfigs(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var location string
+	matrixLocation := d.EqualsQualString(matrixKeyLocation)
+	// Since, when the service API is disabled, matrixLocation value will be nil
+	if matrixLocation != "" {
+		location = matrixLocation
+	}
+
+	// Minimize the API call with given location
+	if d.EqualsQualString("location") != "" && d.EqualsQualString("location") != location {
+		return nil, nil
+	}
+
+	// Max limit is set as per documentation
+	pageSize := types.Int64(100)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	// Create service connection
+	service, err := StorageInsightsService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_storage_insights_report_config.listStorageInsightsReportConfigs", "service_error", err)
+		return nil, err
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	parent := "projects/" + project + "/locations/" + location
+
+	pageToken := ""
+	for {
+		resp, err := service.listReportConfigs(ctx, parent, *pageSize, pageToken)
+		if err != nil {
+			plugin.Logger(ctx).Error("gcp_storage_insights_report_config.listStorageInsightsReportConfigs", "api_error", err)
+			return nil, err
+		}
+
+		for _, item := range resp.ReportConfigs {
+			d.StreamListItem(ctx, item)
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if resp.NextPageToken == "" {
+			break
+		}
+		pageToken = resp.NextPageToken
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getStorageInsightsReportConfig(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	matrixLocation := d.EqualsQualString(matrixKeyLocation)
+
+	name := d.EqualsQualString("name")
+
+	if name == "" {
+		return nil, nil
+	}
+
+	// Restrict the API call for other locations
+	if len(strings.Split(name, "/")) > 3 && strings.Split(name, "/")[3] != matrixLocation {
+		return nil, nil
+	}
+
+	// Create service connection
+	service, err := StorageInsightsService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_storage_insights_report_config.getStorageInsightsReportConfig", "service_error", err)
+		return nil, err
+	}
+
+	resp, err := service.getReportConfig(ctx, name)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_storage_insights_report_config.getStorageInsightsReportConfig", "api_error", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func storageInsightsReportConfigFormat(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	data := d.HydrateItem.(*storageInsightsReportConfig)
+
+	if data.ParquetOptions != nil {
+		return "PARQUET", nil
+	}
+	return "CSV", nil
+}
+
+func storageInsightsReportConfigTitle(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	data := d.HydrateItem.(*storageInsightsReportConfig)
+
+	if data.DisplayName != "" {
+		return data.DisplayName, nil
+	}
+	return getLastPathElement(data.Name), nil
+}
+
+func storageInsightsReportConfigTurbotData(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	data := d.HydrateItem.(*storageInsightsReportConfig)
+	param := d.Param.(string)
+
+	splitName := strings.Split(data.Name, "/")
+
+	turbotData := map[string]interface{}{
+		"Location": splitName[3],
+		"SelfLink": storageInsightsBasePath + "v1/" + data.Name,
+		"Akas":     []string{"gcp://storageinsights.googleapis.com/" + data.Name},
+	}
+
+	return turbotData[param], nil
+}
@@ -0,0 +1,341 @@
+package gcp
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+type storageInsightsReportObjectInfo = struct {
+	ReportConfigName string
+	ReportDetailName string
+	SnapshotTime     string
+	Shard            int64
+	RowNumber        int64
+	Metadata         map[string]string
+}
+
+//// TABLE DEFINITION
+
+func tableGcpStorageInsightsReportObject(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_storage_insights_report_object",
+		Description: "GCP Storage Insights Report Object",
+		List: &plugin.ListConfig{
+			Hydrate: listStorageInsightsReportObjects,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "report_config_name", Require: plugin.Required},
+				{Name: "report_detail_name", Require: plugin.Optional},
+				{Name: "shard", Require: plugin.Optional},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "bucket",
+				Description: "The name of the bucket containing the object.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(storageInsightsReportObjectField, "bucket"),
+			},
+			{
+				Name:        "name",
+				Description: "The name of the object.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(storageInsightsReportObjectField, "name"),
+			},
+			{
+				Name:        "size",
+				Description: "The content length of the object, in bytes.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromP(storageInsightsReportObjectField, "size"),
+			},
+			{
+				Name:        "storage_class",
+				Description: "The storage class of the object.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(storageInsightsReportObjectField, "storageClass"),
+			},
+			{
+				Name:        "content_type",
+				Description: "The content type of the object.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(storageInsightsReportObjectField, "contentType"),
+			},
+			{
+				Name:        "time_created",
+				Description: "The creation time of the object.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromP(storageInsightsReportObjectField, "timeCreated"),
+			},
+			{
+				Name:        "updated",
+				Description: "The modification time of the object metadata.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromP(storageInsightsReportObjectField, "updated"),
+			},
+			{
+				Name:        "report_config_name",
+				Description: "The name of the report config that generated the report, in the format projects/{project}/locations/{location}/reportConfigs/{report_config_id}.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "report_detail_name",
+				Description: "The name of the report the object is listed in. Defaults to the latest successful report of the report config.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "snapshot_time",
+				Description: "The snapshot time of the report. All the object metadata in the report is from before this time.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("SnapshotTime").NullIfZero(),
+			},
+			{
+				Name:        "shard",
+				Description: "The index of the report shard the object is listed in.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "row_number",
+				Description: "The number of the row of the object in the report shard, starting at 1 and excluding the header row.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "metadata",
+				Description: "All the object metadata fields included in the report, keyed by field name.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(storageInsightsReportObjectField, "name"),
+			},
+
+			// standard gcp columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(storageInsightsReportObjectField, "location"),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getProject,
+				Transform:   transform.FromValue(),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listStorageInsightsReportObjects(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	configName := d.EqualsQualString("report_config_name")
+	if configName == "" {
+		return nil, nil
+	}
+
+	// Create service connections
+	service, err := StorageInsightsService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_storage_insights_report_object.listStorageInsightsReportObjects", "service_error", err)
+		return nil, err
+	}
+	storageService, err := StorageService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_storage_insights_report_object.listStorageInsightsReportObjects", "service_error", err)
+		return nil, err
+	}
+
+	config, err := service.getReportConfig(ctx, configName)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_storage_insights_report_object.listStorageInsightsReportObjects", "api_error", err)
+		return nil, err
+	}
+	// Only CSV reports can be read
+	if config.ParquetOptions != nil {
+		return nil, fmt.Errorf("parquet reports are not supported, report config %s must generate CSV reports to be queried", configName)
+	}
+
+	detail, err := getStorageInsightsReportDetail(ctx, d, service, configName)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_storage_insights_report_object.listStorageInsightsReportObjects", "api_error", err)
+		return nil, err
+	}
+	if detail == nil {
+		return nil, nil
+	}
+
+	// The shards are named {report_path_prefix}{shard}.csv, where the prefix is
+	// a gs:// URI, e.g. gs://my-insights/my-config/dt=2024-01-01T00:00/my-config_2024-01-01T00:00_
+	bucket, prefix, _ := strings.Cut(strings.TrimPrefix(detail.ReportPathPrefix, "gs://"), "/")
+
+	var fields []string
+	if config.ObjectMetadataReportOptions != nil {
+		fields = config.ObjectMetadataReportOptions.MetadataFields
+	}
+
+	for shard := int64(0); shard < detail.ShardsCount; shard++ {
+		if d.EqualsQuals["shard"] != nil && d.EqualsQuals["shard"].GetInt64Value() != shard {
+			continue
+		}
+
+		resp, err := storageService.Objects.Get(bucket, prefix+strconv.FormatInt(shard, 10)+".csv").Context(ctx).Download()
+		if err != nil {
+			plugin.Logger(ctx).Error("gcp_storage_insights_report_object.listStorageInsightsReportObjects", "api_error", err)
+			return nil, err
+		}
+
+		reader, err := newStorageInsightsCsvReader(resp.Body, config.CsvOptions)
+		if err != nil {
+			resp.Body.Close()
+			return nil, err
+		}
+
+		done, err := streamStorageInsightsReportShard(ctx, d, reader, fields, config.CsvOptions, storageInsightsReportObjectInfo{
+			ReportConfigName: configName,
+			ReportDetailName: detail.Name,
+			SnapshotTime:     detail.SnapshotTime,
+			Shard:            shard,
+		})
+		resp.Body.Close()
+		if err != nil {
+			plugin.Logger(ctx).Error("gcp_storage_insights_report_object.listStorageInsightsReportObjects", "parse_error", err)
+			return nil, err
+		}
+		if done {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+// getStorageInsightsReportDetail returns the report given in the where clause,
+// or the latest successful report of the report config
+func getStorageInsightsReportDetail(ctx context.Context, d *plugin.QueryData, service *storageInsightsService, configName string) (*storageInsightsReportDetail, error) {
+	if name := d.EqualsQualString("report_detail_name"); name != "" {
+		return service.getReportDetail(ctx, name)
+	}
+
+	var latest *storageInsightsReportDetail
+	pageToken := ""
+	for {
+		resp, err := service.listReportDetails(ctx, configName, 100, pageToken)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, detail := range resp.ReportDetails {
+			if detail.Status != nil && detail.Status.Code != 0 {
+				continue
+			}
+			if latest == nil || detail.SnapshotTime > latest.SnapshotTime {
+				latest = detail
+			}
+		}
+
+		if resp.NextPageToken == "" {
+			break
+		}
+		pageToken = resp.NextPageToken
+	}
+
+	return latest, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func storageInsightsReportObjectField(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	data := d.HydrateItem.(storageInsightsReportObjectInfo)
+	param := d.Param.(string)
+
+	value, ok := data.Metadata[param]
+	if !ok || value == "" {
+		return nil, nil
+	}
+	return value, nil
+}
+
+//// UTILITY FUNCTIONS
+
+// newStorageInsightsCsvReader returns a CSV reader for a report shard, using
+// the delimiter of the report config. The CSV reader only supports \n and \r\n
+// record separators, which are the only ones the report configs accept.
+func newStorageInsightsCsvReader(r io.Reader, options *storageInsightsCsvOptions) (*csv.Reader, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.ReuseRecord = true
+
+	if options == nil {
+		return reader, nil
+	}
+
+	if options.RecordSeparator != "" && options.RecordSeparator != "\n" && options.RecordSeparator != "\r\n" {
+		return nil, fmt.Errorf("unsupported record separator %q, only \\n and \\r\\n are supported", options.RecordSeparator)
+	}
+	if options.Delimiter != "" {
+		delimiter, size := utf8.DecodeRuneInString(options.Delimiter)
+		if size != len(options.Delimiter) {
+			return nil, fmt.Errorf("unsupported delimiter %q, only single character delimiters are supported", options.Delimiter)
+		}
+		reader.Comma = delimiter
+	}
+
+	return reader, nil
+}
+
+// streamStorageInsightsReportShard streams a row per object of a report shard.
+// It returns true if the limit of the query has been hit.
+func streamStorageInsightsReportShard(ctx context.Context, d *plugin.QueryData, reader *csv.Reader, fields []string, options *storageInsightsCsvOptions, info storageInsightsReportObjectInfo) (bool, error) {
+	// The header row has the names of the metadata fields, which are in the
+	// order of the report config otherwise
+	if options != nil && options.HeaderRequired {
+		header, err := reader.Read()
+		if err == io.EOF {
+			return false, nil
+		}
+		if err != nil {
+			return false, err
+		}
+		fields = append([]string{}, header...)
+	}
+
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			return false, nil
+		}
+		if err != nil {
+			return false, err
+		}
+
+		info.RowNumber++
+		info.Metadata = make(map[string]string, len(record))
+		for i, value := range record {
+			if i < len(fields) {
+				info.Metadata[fields[i]] = value
+			}
+		}
+		d.StreamListItem(ctx, info)
+
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return true, nil
+		}
+	}
+}
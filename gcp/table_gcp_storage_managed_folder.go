@@ -0,0 +1,222 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	"google.golang.org/api/storage/v1"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpStorageManagedFolder(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_storage_managed_folder",
+		Description: "GCP Storage Managed Folder",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"bucket", "name"}),
+			Hydrate:    getStorageManagedFolder,
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listGcpStorageBuckets,
+			Hydrate:       listStorageManagedFolders,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "bucket", Require: plugin.Optional},
+				{Name: "prefix", Require: plugin.Optional, CacheMatch: "exact"},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the managed folder, relative to the bucket, e.g. folder1/.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "bucket",
+				Description: "The name of the bucket containing the managed folder.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the managed folder, including the bucket name and managed folder name.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "prefix",
+				Description: "The prefix used to filter the managed folders to list. Only set if specified in the where clause.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("prefix"),
+			},
+			{
+				Name:        "create_time",
+				Description: "The creation time of the managed folder.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("CreateTime").NullIfZero(),
+			},
+			{
+				Name:        "update_time",
+				Description: "The last modification time of the managed folder metadata.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("UpdateTime").NullIfZero(),
+			},
+			{
+				Name:        "metageneration",
+				Description: "The version of the metadata for this managed folder. Used for preconditions and for detecting changes in metadata.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "kind",
+				Description: "The kind of item this is. For managed folders, this is always storage#managedFolder.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "self_link",
+				Description: "The link to this managed folder.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "iam_policy",
+				Description: "An Identity and Access Management (IAM) policy, which specifies access controls for the managed folder, in addition to the access controls of the bucket.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getStorageManagedFolderIamPolicy,
+				Transform:   transform.FromValue(),
+			},
+
+			// standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.From(storageManagedFolderAkas),
+			},
+
+			// standard gcp columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromConstant("global"),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getProject,
+				Transform:   transform.FromValue(),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listStorageManagedFolders(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	bucket := h.Item.(*storage.Bucket)
+
+	// Minimize the API call with given bucket
+	if d.EqualsQualString("bucket") != "" && d.EqualsQualString("bucket") != bucket.Name {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := StorageService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_storage_managed_folder.listStorageManagedFolders", "service_error", err)
+		return nil, err
+	}
+
+	// Max limit is set as per documentation
+	pageSize := types.Int64(1000)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	resp := service.ManagedFolders.List(bucket.Name).PageSize(*pageSize)
+	if prefix := d.EqualsQualString("prefix"); prefix != "" {
+		resp.Prefix(prefix)
+	}
+
+	if err := resp.Pages(ctx, func(page *storage.ManagedFolders) error {
+		for _, folder := range page.Items {
+			d.StreamListItem(ctx, folder)
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				return nil
+			}
+		}
+		return nil
+	}); err != nil {
+		plugin.Logger(ctx).Error("gcp_storage_managed_folder.listStorageManagedFolders", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getStorageManagedFolder(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	bucket := d.EqualsQualString("bucket")
+	name := d.EqualsQualString("name")
+
+	// Return nil, if no input provided
+	if bucket == "" || name == "" {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := StorageService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_storage_managed_folder.getStorageManagedFolder", "service_error", err)
+		return nil, err
+	}
+
+	resp, err := service.ManagedFolders.Get(bucket, name).Do()
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_storage_managed_folder.getStorageManagedFolder", "api_error", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func getStorageManagedFolderIamPolicy(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	folder := h.Item.(*storage.ManagedFolder)
+
+	// Create Service Connection
+	service, err := StorageService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_storage_managed_folder.getStorageManagedFolderIamPolicy", "service_error", err)
+		return nil, err
+	}
+
+	resp, err := service.ManagedFolders.GetIamPolicy(folder.Bucket, folder.Name).Do()
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_storage_managed_folder.getStorageManagedFolderIamPolicy", "api_error", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func storageManagedFolderAkas(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	folder := d.HydrateItem.(*storage.ManagedFolder)
+	return []string{"gcp://storage.googleapis.com/buckets/" + folder.Bucket + "/managedFolders/" + folder.Name}, nil
+}
@@ -0,0 +1,197 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	"google.golang.org/api/storage/v1"
+)
+
+type storageNotificationInfo = struct {
+	Bucket       string
+	Notification *storage.Notification
+}
+
+//// TABLE DEFINITION
+
+func tableGcpStorageNotification(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_storage_notification",
+		Description: "GCP Storage Notification",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"bucket", "id"}),
+			Hydrate:    getStorageNotification,
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listGcpStorageBuckets,
+			Hydrate:       listStorageNotifications,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "bucket", Require: plugin.Optional},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "id",
+				Description: "The ID of the notification.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Notification.Id"),
+			},
+			{
+				Name:        "bucket",
+				Description: "The name of the bucket the notification configuration is for.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "topic",
+				Description: "The Pub/Sub topic the notifications are published to, in the format //pubsub.googleapis.com/projects/{project}/topics/{topic}.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Notification.Topic"),
+			},
+			{
+				Name:        "payload_format",
+				Description: "The desired content of the payload, either JSON_API_V1 or NONE.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Notification.PayloadFormat"),
+			},
+			{
+				Name:        "object_name_prefix",
+				Description: "If present, only send notifications about objects whose names begin with this prefix.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Notification.ObjectNamePrefix"),
+			},
+			{
+				Name:        "etag",
+				Description: "HTTP 1.1 Entity tag for the notification.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Notification.Etag"),
+			},
+			{
+				Name:        "kind",
+				Description: "The kind of item this is. For notifications, this is always storage#notification.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Notification.Kind"),
+			},
+			{
+				Name:        "self_link",
+				Description: "The canonical URL of this notification.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Notification.SelfLink"),
+			},
+			{
+				Name:        "custom_attributes",
+				Description: "An optional list of additional attributes to attach to each Pub/Sub message published for the notification.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Notification.CustomAttributes"),
+			},
+			{
+				Name:        "event_types",
+				Description: "If present, only send notifications about the listed event types. If empty, notifications are sent for all event types.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Notification.EventTypes"),
+			},
+
+			// standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Notification.Id"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.From(storageNotificationAkas),
+			},
+
+			// standard gcp columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromConstant("global"),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getProject,
+				Transform:   transform.FromValue(),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listStorageNotifications(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	bucket := h.Item.(*storage.Bucket)
+
+	// Minimize the API call with given bucket
+	if d.EqualsQualString("bucket") != "" && d.EqualsQualString("bucket") != bucket.Name {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := StorageService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_storage_notification.listStorageNotifications", "service_error", err)
+		return nil, err
+	}
+
+	// The API doesn't paginate notification configurations, a bucket can have
+	// at most 100 of them
+	resp, err := service.Notifications.List(bucket.Name).Do()
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_storage_notification.listStorageNotifications", "api_error", err)
+		return nil, err
+	}
+
+	for _, notification := range resp.Items {
+		d.StreamListItem(ctx, storageNotificationInfo{bucket.Name, notification})
+
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getStorageNotification(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	bucket := d.EqualsQualString("bucket")
+	id := d.EqualsQualString("id")
+
+	// Return nil, if no input provided
+	if bucket == "" || id == "" {
+		return nil, nil
+	}
+
+	// Create Service Connection
+	service, err := StorageService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_storage_notification.getStorageNotification", "service_error", err)
+		return nil, err
+	}
+
+	resp, err := service.Notifications.Get(bucket, id).Do()
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_storage_notification.getStorageNotification", "api_error", err)
+		return nil, err
+	}
+
+	return storageNotificationInfo{bucket, resp}, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func storageNotificationAkas(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	data := d.HydrateItem.(storageNotificationInfo)
+	return []string{"gcp://storage.googleapis.com/buckets/" + data.Bucket + "/notificationConfigs/" + data.Notification.Id}, nil
+}
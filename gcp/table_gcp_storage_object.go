@@ -16,14 +16,22 @@ func tableGcpStorageObject(_ context.Context) *plugin.Table {
 	return &plugin.Table{
 		Name:        "gcp_storage_object",
 		Description: "GCP Storage Object",
-		Get: &plugin.GetConfig{
-			KeyColumns: plugin.AllColumns([]string{"bucket", "name"}),
-			Hydrate:    getStorageObject,
-		},
+		// An object is looked up by name in the list function, since a name may
+		// match several rows when listing its versions or soft-deleted objects
 		List: &plugin.ListConfig{
+			// The optional key columns change the objects returned by the API, so
+			// a cached result can only be reused for the exact same values
 			KeyColumns: []*plugin.KeyColumn{
 				{Name: "bucket", Require: plugin.Required, CacheMatch: "exact"},
+				{Name: "name", Require: plugin.Optional, CacheMatch: "exact"},
+				{Name: "generation", Require: plugin.Optional, Operators: []string{"="}, CacheMatch: "exact"},
 				{Name: "prefix", Require: plugin.Optional},
+				{Name: "versions", Require: plugin.Optional, Operators: []string{"="}, CacheMatch: "exact"},
+				{Name: "soft_deleted", Require: plugin.Optional, Operators: []string{"="}, CacheMatch: "exact"},
+				{Name: "delimiter", Require: plugin.Optional, CacheMatch: "exact"},
+				{Name: "match_glob", Require: plugin.Optional, CacheMatch: "exact"},
+				{Name: "start_offset", Require: plugin.Optional, CacheMatch: "exact"},
+				{Name: "end_offset", Require: plugin.Optional, CacheMatch: "exact"},
 			},
 			Hydrate: listStorageObjects,
 		},
@@ -39,6 +47,48 @@ func tableGcpStorageObject(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_STRING,
 				Transform:   transform.FromQual("prefix"),
 			},
+			{
+				Name:        "versions",
+				Description: "If true, lists all the versions of the objects, including noncurrent versions, instead of only the live versions.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromQual("versions"),
+			},
+			{
+				Name:        "soft_deleted",
+				Description: "True if the object is soft-deleted. Set to true in the where clause to list or get soft-deleted objects instead of live objects.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.From(storageObjectIsSoftDeleted),
+			},
+			{
+				Name:        "delimiter",
+				Description: "The delimiter used to browse the objects as a directory hierarchy, e.g. /. Objects whose names contain the delimiter after the prefix are returned as a single prefix row instead.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("delimiter"),
+			},
+			{
+				Name:        "is_prefix",
+				Description: "True if the row is a prefix returned when listing with a delimiter, e.g. a folder, rather than an object.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.From(storageObjectIsPrefix),
+			},
+			{
+				Name:        "match_glob",
+				Description: "A glob pattern used to filter the objects by name, e.g. **/*.json.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("match_glob"),
+			},
+			{
+				Name:        "start_offset",
+				Description: "Filters the objects to those whose names are lexicographically equal to or after this value.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("start_offset"),
+			},
+			{
+				Name:        "end_offset",
+				Description: "Filters the objects to those whose names are lexicographically before this value.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("end_offset"),
+			},
 			{
 				Name:        "id",
 				Description: "The ID of the object, including the bucket name, object name, and generation number.",
@@ -174,6 +224,18 @@ func tableGcpStorageObject(_ context.Context) *plugin.Table {
 				Transform:   transform.FromGo().NullIfZero(),
 				Type:        proto.ColumnType_TIMESTAMP,
 			},
+			{
+				Name:        "soft_delete_time",
+				Description: "The time at which the object became soft-deleted.",
+				Transform:   transform.FromGo().NullIfZero(),
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "hard_delete_time",
+				Description: "The time at which a soft-deleted object will be permanently deleted, after which it can no longer be restored.",
+				Transform:   transform.FromGo().NullIfZero(),
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
 			{
 				Name:        "time_storage_class_updated",
 				Description: "The time at which the object's storage class was last changed.",
@@ -242,13 +304,38 @@ func tableGcpStorageObject(_ context.Context) *plugin.Table {
 
 func listStorageObjects(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
 	bucket := d.EqualsQualString("bucket")
+	name := d.EqualsQualString("name")
 	prefix := d.EqualsQualString("prefix")
+	versions := d.EqualsQuals["versions"] != nil && d.EqualsQuals["versions"].GetBoolValue()
+	softDeleted := d.EqualsQuals["soft_deleted"] != nil && d.EqualsQuals["soft_deleted"].GetBoolValue()
 
 	// The bucket name should not be empty
 	if bucket == "" {
 		return nil, nil
 	}
 
+	// A single object is returned for a given generation, or for the live
+	// version of the object
+	if name != "" && (d.EqualsQuals["generation"] != nil || (!versions && !softDeleted)) {
+		object, err := getStorageObject(ctx, d, h)
+		if err != nil {
+			return nil, err
+		}
+		if object != nil {
+			d.StreamListItem(ctx, object)
+		}
+		return nil, nil
+	}
+
+	// Otherwise, the versions or soft-deleted objects of the given name are
+	// listed with the name as prefix, and the other objects are filtered out
+	if name != "" {
+		if !strings.HasPrefix(name, prefix) {
+			return nil, nil
+		}
+		prefix = name
+	}
+
 	service, err := StorageService(ctx, d)
 	if err != nil {
 		plugin.Logger(ctx).Trace("gcp_storage_object.listStorageObjects", "connection_error", err)
@@ -259,15 +346,53 @@ func listStorageObjects(ctx context.Context, d *plugin.QueryData, h *plugin.Hydr
 	// Default limit is set as 1000
 	maxResults := types.Int64(1000)
 	limit := d.QueryContext.Limit
-	if d.QueryContext.Limit != nil {
+	if d.QueryContext.Limit != nil && name == "" {
 		if *limit < *maxResults {
 			maxResults = limit
 		}
 	}
 
 	resp := service.Objects.List(bucket).Prefix(prefix).Projection("full").MaxResults(*maxResults)
+	if d.EqualsQuals["versions"] != nil {
+		resp.Versions(versions)
+	}
+	if d.EqualsQuals["soft_deleted"] != nil {
+		resp.SoftDeleted(softDeleted)
+	}
+	if delimiter := d.EqualsQualString("delimiter"); delimiter != "" {
+		resp.Delimiter(delimiter)
+	}
+	if matchGlob := d.EqualsQualString("match_glob"); matchGlob != "" {
+		resp.MatchGlob(matchGlob)
+	}
+	if startOffset := d.EqualsQualString("start_offset"); startOffset != "" {
+		resp.StartOffset(startOffset)
+	}
+	if endOffset := d.EqualsQualString("end_offset"); endOffset != "" {
+		resp.EndOffset(endOffset)
+	}
+
 	if err := resp.Pages(ctx, func(page *storage.Objects) error {
+		// When listing with a delimiter, the objects nested under the prefix are
+		// grouped into prefixes, which are returned as rows without an ID
+		for _, objectPrefix := range page.Prefixes {
+			if name != "" && objectPrefix != name {
+				continue
+			}
+			d.StreamListItem(ctx, &storage.Object{Bucket: bucket, Name: objectPrefix})
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				return nil
+			}
+		}
+
 		for _, object := range page.Items {
+			if name != "" && object.Name != name {
+				continue
+			}
 			d.StreamListItem(ctx, object)
 
 			// Check if context has been cancelled or if the limit has been hit (if specified)
@@ -303,8 +428,21 @@ func getStorageObject(ctx context.Context, d *plugin.QueryData, h *plugin.Hydrat
 		return nil, err
 	}
 
-	req, err := service.Objects.Get(bucket, name).Do()
+	call := service.Objects.Get(bucket, name)
+	// Without a generation, the live version of the object is returned
+	if d.EqualsQuals["generation"] != nil {
+		call.Generation(d.EqualsQuals["generation"].GetInt64Value())
+	}
+	if d.EqualsQuals["soft_deleted"] != nil && d.EqualsQuals["soft_deleted"].GetBoolValue() {
+		call.SoftDeleted(true)
+	}
+
+	req, err := call.Do()
 	if err != nil {
+		// The object, or the given generation of the object, does not exist
+		if gerr, ok := err.(*googleapi.Error); ok && gerr.Code == 404 {
+			return nil, nil
+		}
 		plugin.Logger(ctx).Trace("gcp_storage_object.getStorageObject", "api_error", err)
 		return nil, err
 	}
@@ -315,6 +453,11 @@ func getStorageObject(ctx context.Context, d *plugin.QueryData, h *plugin.Hydrat
 func getStorageObjectIAMPolicy(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
 	object := h.Item.(*storage.Object)
 
+	// Prefixes returned when listing with a delimiter are not objects
+	if object.Id == "" {
+		return nil, nil
+	}
+
 	// Create Session
 	service, err := StorageService(ctx, d)
 	if err != nil {
@@ -351,3 +494,15 @@ func getObjectAka(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateDat
 	akas := []string{"gcp://storage.googleapis.com/projects/" + project + "/buckets/" + object.Bucket + "/objects/" + object.Name}
 	return akas, nil
 }
+
+//// TRANSFORM FUNCTIONS
+
+func storageObjectIsPrefix(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	object := d.HydrateItem.(*storage.Object)
+	return object.Id == "", nil
+}
+
+func storageObjectIsSoftDeleted(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	object := d.HydrateItem.(*storage.Object)
+	return object.SoftDeleteTime != "", nil
+}
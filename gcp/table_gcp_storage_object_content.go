@@ -0,0 +1,283 @@
+package gcp
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"unicode/utf8"
+
+	"github.com/ghodss/yaml"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/storage/v1"
+)
+
+// The content of objects larger than this is not downloaded, unless
+// storage_object_content_max_bytes is set in the connection config
+const defaultStorageObjectContentMaxBytes = 1048576
+
+type storageObjectContentInfo = struct {
+	Object          *storage.Object
+	Content         []byte
+	ExceedsMaxBytes bool
+	LineNumber      int
+	CsvRow          map[string]string
+}
+
+//// TABLE DEFINITION
+
+func tableGcpStorageObjectContent(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_storage_object_content",
+		Description: "GCP Storage Object Content",
+		List: &plugin.ListConfig{
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "bucket", Require: plugin.Required, CacheMatch: "exact"},
+				{Name: "name", Require: plugin.Required, CacheMatch: "exact"},
+				{Name: "generation", Require: plugin.Optional, CacheMatch: "exact"},
+				{Name: "parse_csv", Require: plugin.Optional, Operators: []string{"="}, CacheMatch: "exact"},
+			},
+			Hydrate: listStorageObjectContents,
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "bucket",
+				Description: "The name of the bucket containing the object.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Object.Bucket"),
+			},
+			{
+				Name:        "name",
+				Description: "The name of the object.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Object.Name"),
+			},
+			{
+				Name:        "generation",
+				Description: "The content generation of the object. Defaults to the live version of the object.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Object.Generation"),
+			},
+			{
+				Name:        "size",
+				Description: "Content-Length of the data in bytes.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Object.Size"),
+			},
+			{
+				Name:        "content_type",
+				Description: "Content-Type of the object data.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Object.ContentType"),
+			},
+			{
+				Name:        "exceeds_max_bytes",
+				Description: "True if the object, or its decompressed content for objects stored with gzip encoding, is larger than the storage_object_content_max_bytes connection setting, in which case its content is not returned.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "content",
+				Description: "The content of the object, decoded as UTF-8 text. Null if the content is not valid UTF-8, or if the object is parsed as CSV.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(storageObjectContentText),
+			},
+			{
+				Name:        "content_json",
+				Description: "The content of the object, decoded as JSON. Null if the content is not valid JSON, or if the object is parsed as CSV.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.From(storageObjectContentJSON),
+			},
+			{
+				Name:        "content_yaml",
+				Description: "The content of the object, decoded as YAML. Null if the content is not valid YAML, or if the object is parsed as CSV.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.From(storageObjectContentYAML),
+			},
+			{
+				Name:        "parse_csv",
+				Description: "If true, the object is parsed as CSV with a header line, and one row is returned per record.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromQual("parse_csv"),
+			},
+			{
+				Name:        "line_number",
+				Description: "The line number of the CSV record, starting at 2 after the header line. Only set when parsing the object as CSV.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("LineNumber").NullIfZero(),
+			},
+			{
+				Name:        "csv_row",
+				Description: "The values of the CSV record, keyed by the names of the header line. Only set when parsing the object as CSV.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Object.Name"),
+			},
+
+			// standard GCP columns
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getProject,
+				Transform:   transform.FromValue(),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listStorageObjectContents(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	bucket := d.EqualsQualString("bucket")
+	name := d.EqualsQualString("name")
+
+	// Return nil, if input parameters are empty
+	if bucket == "" || name == "" {
+		return nil, nil
+	}
+
+	service, err := StorageService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_storage_object_content.listStorageObjectContents", "connection_error", err)
+		return nil, err
+	}
+
+	// Get the object metadata first, to check its size before downloading it
+	call := service.Objects.Get(bucket, name)
+	if d.EqualsQuals["generation"] != nil {
+		call.Generation(d.EqualsQuals["generation"].GetInt64Value())
+	}
+	object, err := call.Do()
+	if err != nil {
+		// The object, or the given generation of the object, does not exist
+		if gerr, ok := err.(*googleapi.Error); ok && gerr.Code == 404 {
+			return nil, nil
+		}
+		plugin.Logger(ctx).Error("gcp_storage_object_content.listStorageObjectContents", "api_error", err)
+		return nil, err
+	}
+
+	maxBytes := int64(defaultStorageObjectContentMaxBytes)
+	if config := GetConfig(d.Connection); config.ObjectContentMaxBytes != nil {
+		maxBytes = *config.ObjectContentMaxBytes
+	}
+	if int64(object.Size) > maxBytes {
+		d.StreamListItem(ctx, storageObjectContentInfo{Object: object, ExceedsMaxBytes: true})
+		return nil, nil
+	}
+
+	// Download the generation that was checked, in case the object was replaced since
+	resp, err := service.Objects.Get(bucket, name).Generation(object.Generation).Context(ctx).Download()
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_storage_object_content.listStorageObjectContents", "api_error", err)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	// Objects stored with gzip encoding are decompressed on download, so the
+	// content can be larger than the object size
+	content, err := io.ReadAll(io.LimitReader(resp.Body, maxBytes+1))
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_storage_object_content.listStorageObjectContents", "read_error", err)
+		return nil, err
+	}
+	if int64(len(content)) > maxBytes {
+		d.StreamListItem(ctx, storageObjectContentInfo{Object: object, ExceedsMaxBytes: true})
+		return nil, nil
+	}
+
+	if d.EqualsQuals["parse_csv"] == nil || !d.EqualsQuals["parse_csv"].GetBoolValue() {
+		d.StreamListItem(ctx, storageObjectContentInfo{Object: object, Content: content})
+		return nil, nil
+	}
+
+	reader := csv.NewReader(bytes.NewReader(content))
+	// Records may have a different number of fields than the header
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, nil
+	}
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_storage_object_content.listStorageObjectContents", "csv_error", err)
+		return nil, err
+	}
+
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			plugin.Logger(ctx).Error("gcp_storage_object_content.listStorageObjectContents", "csv_error", err)
+			return nil, err
+		}
+
+		row := map[string]string{}
+		for i, value := range record {
+			if i < len(header) {
+				row[header[i]] = value
+			}
+		}
+		line, _ := reader.FieldPos(0)
+		d.StreamListItem(ctx, storageObjectContentInfo{Object: object, LineNumber: line, CsvRow: row})
+
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			break
+		}
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func storageObjectContentText(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	data := d.HydrateItem.(storageObjectContentInfo)
+
+	if data.ExceedsMaxBytes || data.CsvRow != nil || !utf8.Valid(data.Content) {
+		return nil, nil
+	}
+	return string(data.Content), nil
+}
+
+func storageObjectContentJSON(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	data := d.HydrateItem.(storageObjectContentInfo)
+
+	if data.ExceedsMaxBytes || data.CsvRow != nil {
+		return nil, nil
+	}
+
+	var content interface{}
+	if err := json.Unmarshal(data.Content, &content); err != nil {
+		return nil, nil
+	}
+	return content, nil
+}
+
+func storageObjectContentYAML(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	data := d.HydrateItem.(storageObjectContentInfo)
+
+	if data.ExceedsMaxBytes || data.CsvRow != nil {
+		return nil, nil
+	}
+
+	var content interface{}
+	if err := yaml.Unmarshal(data.Content, &content); err != nil {
+		return nil, nil
+	}
+	return content, nil
+}
@@ -0,0 +1,269 @@
+package gcp
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	"google.golang.org/api/storagetransfer/v1"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpStorageTransferJob(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_storage_transfer_job",
+		Description: "GCP Storage Transfer Job",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			Hydrate:    getStorageTransferJob,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listStorageTransferJobs,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "status", Require: plugin.Optional},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the transfer job, in the format transferJobs/{job_id}.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "A description provided by the user for the job.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status",
+				Description: "The status of the job, either ENABLED, DISABLED or DELETED. Deleted jobs are only listed if the status is specified in the where clause.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "source_type",
+				Description: "The type of the data source of the job, e.g. gcs, aws_s3, azure_blob_storage, http, posix, hdfs or aws_s3_compatible.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("TransferSpec").Transform(storageTransferSourceType),
+			},
+			{
+				Name:        "sink_bucket",
+				Description: "The Cloud Storage bucket the data is transferred to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("TransferSpec.GcsDataSink.BucketName"),
+			},
+			{
+				Name:        "repeat_interval",
+				Description: "The interval between the start of each scheduled transfer operation, e.g. 86400s. Null for jobs that run once.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Schedule.RepeatInterval"),
+			},
+			{
+				Name:        "latest_operation_name",
+				Description: "The name of the most recently started transfer operation of the job.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "creation_time",
+				Description: "The time the transfer job was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("CreationTime").NullIfZero(),
+			},
+			{
+				Name:        "last_modification_time",
+				Description: "The time the transfer job was last modified.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("LastModificationTime").NullIfZero(),
+			},
+			{
+				Name:        "deletion_time",
+				Description: "The time the transfer job was deleted.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("DeletionTime").NullIfZero(),
+			},
+			{
+				Name:        "event_stream",
+				Description: "The event stream the job listens to for event driven transfers.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "logging_config",
+				Description: "The logging configuration of the job.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "notification_config",
+				Description: "The Pub/Sub notification configuration of the job.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "replication_spec",
+				Description: "The replication specification of the job, for jobs that replicate new objects between buckets.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "schedule",
+				Description: "The schedule of the job, including the start and end dates, the start time of day and the repeat interval.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "transfer_spec",
+				Description: "The transfer specification of the job, including the data source, the data sink, the object conditions and the transfer options.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Name").Transform(storageTransferAkas),
+			},
+
+			// standard gcp columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromConstant("global"),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ProjectId"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listStorageTransferJobs(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Create service connection
+	service, err := StorageTransferService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_storage_transfer_job.listStorageTransferJobs", "service_error", err)
+		return nil, err
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	// Max limit isn't mentioned in the documentation
+	// Default limit is set as 256
+	pageSize := types.Int64(256)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	// The filter is a JSON object, deleted jobs are only listed if requested
+	filter := map[string]interface{}{"projectId": project}
+	if status := d.EqualsQualString("status"); status != "" {
+		filter["jobStatuses"] = []string{status}
+	}
+	filterJson, err := json.Marshal(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := service.TransferJobs.List(string(filterJson)).PageSize(*pageSize)
+	if err := resp.Pages(ctx, func(page *storagetransfer.ListTransferJobsResponse) error {
+		for _, job := range page.TransferJobs {
+			d.StreamListItem(ctx, job)
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				return nil
+			}
+		}
+		return nil
+	}); err != nil {
+		plugin.Logger(ctx).Error("gcp_storage_transfer_job.listStorageTransferJobs", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getStorageTransferJob(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	name := d.EqualsQualString("name")
+
+	if name == "" {
+		return nil, nil
+	}
+
+	// Create service connection
+	service, err := StorageTransferService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_storage_transfer_job.getStorageTransferJob", "service_error", err)
+		return nil, err
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	resp, err := service.TransferJobs.Get(name, project).Do()
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_storage_transfer_job.getStorageTransferJob", "api_error", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func storageTransferSourceType(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	spec, ok := d.Value.(*storagetransfer.TransferSpec)
+	if !ok || spec == nil {
+		return nil, nil
+	}
+
+	switch {
+	case spec.GcsDataSource != nil:
+		return "gcs", nil
+	case spec.AwsS3DataSource != nil:
+		return "aws_s3", nil
+	case spec.AwsS3CompatibleDataSource != nil:
+		return "aws_s3_compatible", nil
+	case spec.AzureBlobStorageDataSource != nil:
+		return "azure_blob_storage", nil
+	case spec.HttpDataSource != nil:
+		return "http", nil
+	case spec.PosixDataSource != nil:
+		return "posix", nil
+	case spec.HdfsDataSource != nil:
+		return "hdfs", nil
+	}
+	return nil, nil
+}
+
+func storageTransferAkas(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	name := d.Value.(string)
+	return []string{"gcp://storagetransfer.googleapis.com/" + name}, nil
+}
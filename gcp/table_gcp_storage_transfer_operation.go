@@ -0,0 +1,298 @@
+package gcp
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	"google.golang.org/api/storagetransfer/v1"
+)
+
+type storageTransferOperationInfo = struct {
+	Operation *storagetransfer.Operation
+	Metadata  *storagetransfer.TransferOperation
+}
+
+//// TABLE DEFINITION
+
+func tableGcpStorageTransferOperation(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_storage_transfer_operation",
+		Description: "GCP Storage Transfer Operation",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			Hydrate:    getStorageTransferOperation,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listStorageTransferOperations,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "transfer_job_name", Require: plugin.Optional},
+				{Name: "status", Require: plugin.Optional},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the transfer operation, in the format transferOperations/{operation_id}.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Operation.Name"),
+			},
+			{
+				Name:        "transfer_job_name",
+				Description: "The name of the transfer job that started the operation.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Metadata.TransferJobName"),
+			},
+			{
+				Name:        "status",
+				Description: "The status of the operation, e.g. QUEUED, IN_PROGRESS, PAUSED, SUCCESS, FAILED or ABORTED.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Metadata.Status"),
+			},
+			{
+				Name:        "done",
+				Description: "True if the operation is completed.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Operation.Done"),
+			},
+			{
+				Name:        "start_time",
+				Description: "The time the transfer operation started.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Metadata.StartTime").NullIfZero(),
+			},
+			{
+				Name:        "end_time",
+				Description: "The time the transfer operation ended.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Metadata.EndTime").NullIfZero(),
+			},
+			{
+				Name:        "source_type",
+				Description: "The type of the data source of the operation, e.g. gcs, aws_s3, azure_blob_storage, http, posix, hdfs or aws_s3_compatible.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Metadata.TransferSpec").Transform(storageTransferSourceType),
+			},
+			{
+				Name:        "bytes_found_from_source",
+				Description: "The number of bytes found in the data source that are scheduled to be transferred.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Metadata.Counters.BytesFoundFromSource"),
+			},
+			{
+				Name:        "bytes_copied_to_sink",
+				Description: "The number of bytes completely copied to the data sink.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Metadata.Counters.BytesCopiedToSink"),
+			},
+			{
+				Name:        "bytes_from_source_failed",
+				Description: "The number of bytes in the data source that failed to be transferred or that were deleted after being listed.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Metadata.Counters.BytesFromSourceFailed"),
+			},
+			{
+				Name:        "bytes_from_source_skipped_by_sync",
+				Description: "The number of bytes in the data source that are not transferred because they already exist in the data sink.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Metadata.Counters.BytesFromSourceSkippedBySync"),
+			},
+			{
+				Name:        "objects_found_from_source",
+				Description: "The number of objects found in the data source that are scheduled to be transferred.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Metadata.Counters.ObjectsFoundFromSource"),
+			},
+			{
+				Name:        "objects_copied_to_sink",
+				Description: "The number of objects completely copied to the data sink.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Metadata.Counters.ObjectsCopiedToSink"),
+			},
+			{
+				Name:        "objects_from_source_failed",
+				Description: "The number of objects in the data source that failed to be transferred or that were deleted after being listed.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Metadata.Counters.ObjectsFromSourceFailed"),
+			},
+			{
+				Name:        "objects_from_source_skipped_by_sync",
+				Description: "The number of objects in the data source that are not transferred because they already exist in the data sink.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Metadata.Counters.ObjectsFromSourceSkippedBySync"),
+			},
+			{
+				Name:        "counters",
+				Description: "All the information about the progress of the transfer operation.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Metadata.Counters"),
+			},
+			{
+				Name:        "error",
+				Description: "The error result of the operation, if it failed or was cancelled.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Operation.Error"),
+			},
+			{
+				Name:        "error_breakdowns",
+				Description: "A summary of the errors encountered during the transfer operation, grouped by error code.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Metadata.ErrorBreakdowns"),
+			},
+			{
+				Name:        "logging_config",
+				Description: "The logging configuration of the transfer operation.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Metadata.LoggingConfig"),
+			},
+			{
+				Name:        "notification_config",
+				Description: "The Pub/Sub notification configuration of the transfer operation.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Metadata.NotificationConfig"),
+			},
+			{
+				Name:        "transfer_spec",
+				Description: "The transfer specification of the operation, copied from the transfer job when the operation started.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Metadata.TransferSpec"),
+			},
+
+			// standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Operation.Name"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Operation.Name").Transform(storageTransferAkas),
+			},
+
+			// standard gcp columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromConstant("global"),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getProject,
+				Transform:   transform.FromValue(),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listStorageTransferOperations(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Create service connection
+	service, err := StorageTransferService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_storage_transfer_operation.listStorageTransferOperations", "service_error", err)
+		return nil, err
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	// Max limit isn't mentioned in the documentation
+	// Default limit is set as 256
+	pageSize := types.Int64(256)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	// The filter is a JSON object
+	filter := map[string]interface{}{"projectId": project}
+	if jobName := d.EqualsQualString("transfer_job_name"); jobName != "" {
+		filter["jobNames"] = []string{jobName}
+	}
+	if status := d.EqualsQualString("status"); status != "" {
+		filter["transferStatuses"] = []string{status}
+	}
+	filterJson, err := json.Marshal(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := service.TransferOperations.List("transferOperations", string(filterJson)).PageSize(*pageSize)
+	if err := resp.Pages(ctx, func(page *storagetransfer.ListOperationsResponse) error {
+		for _, operation := range page.Operations {
+			info, err := newStorageTransferOperationInfo(operation)
+			if err != nil {
+				return err
+			}
+			d.StreamListItem(ctx, info)
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				return nil
+			}
+		}
+		return nil
+	}); err != nil {
+		plugin.Logger(ctx).Error("gcp_storage_transfer_operation.listStorageTransferOperations", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getStorageTransferOperation(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	name := d.EqualsQualString("name")
+
+	if name == "" {
+		return nil, nil
+	}
+
+	// Create service connection
+	service, err := StorageTransferService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_storage_transfer_operation.getStorageTransferOperation", "service_error", err)
+		return nil, err
+	}
+
+	resp, err := service.TransferOperations.Get(name).Do()
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_storage_transfer_operation.getStorageTransferOperation", "api_error", err)
+		return nil, err
+	}
+
+	return newStorageTransferOperationInfo(resp)
+}
+
+//// UTILITY FUNCTIONS
+
+// newStorageTransferOperationInfo decodes the metadata of a long running
+// operation, which holds the transfer operation
+func newStorageTransferOperationInfo(operation *storagetransfer.Operation) (storageTransferOperationInfo, error) {
+	metadata := &storagetransfer.TransferOperation{}
+	if len(operation.Metadata) > 0 {
+		if err := json.Unmarshal(operation.Metadata, metadata); err != nil {
+			return storageTransferOperationInfo{}, err
+		}
+	}
+	return storageTransferOperationInfo{operation, metadata}, nil
+}
@@ -0,0 +1,339 @@
+package gcp
+
+import (
+	"context"
+	"strings"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	"google.golang.org/api/tpu/v2"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpTPUNode(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_tpu_node",
+		Description: "GCP Cloud TPU Node",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			Hydrate:    getTPUNode,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listTPUNodes,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "location", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: BuildTPULocationList,
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the TPU node, in the format projects/{project}/locations/{zone}/nodes/{node_id}.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The unique identifier for the TPU node.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "state",
+				Description: "The current state of the TPU node, e.g. CREATING, READY, REPAIRING, STOPPED or PREEMPTED.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "health",
+				Description: "The health status of the TPU node, e.g. HEALTHY, TIMEOUT, UNHEALTHY_MAINTENANCE or UNHEALTHY_TENSORFLOW.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "health_description",
+				Description: "Additional information about the health of the TPU node.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "accelerator_type",
+				Description: "The type of hardware accelerators of the TPU node, e.g. v4-8.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "accelerator_config_type",
+				Description: "The TPU generation of the accelerator config of the TPU node, e.g. V4 or V5P.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AcceleratorConfig.Type"),
+			},
+			{
+				Name:        "accelerator_config_topology",
+				Description: "The topology of the TPU slice of the TPU node, e.g. 2x2x1.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AcceleratorConfig.Topology"),
+			},
+			{
+				Name:        "runtime_version",
+				Description: "The runtime version running in the TPU node.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "api_version",
+				Description: "The API version that created the TPU node.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "The user-supplied description of the TPU node.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "cidr_block",
+				Description: "The CIDR block that the TPU node uses when selecting an IP address.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "create_time",
+				Description: "The time when the TPU node was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("CreateTime").NullIfZero(),
+			},
+			{
+				Name:        "preemptible",
+				Description: "True if the TPU node is preemptible.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("SchedulingConfig.Preemptible"),
+			},
+			{
+				Name:        "spot",
+				Description: "True if the TPU node is a Spot VM.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("SchedulingConfig.Spot"),
+			},
+			{
+				Name:        "reserved",
+				Description: "True if the TPU node is created in the reserved capacity of the project.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("SchedulingConfig.Reserved"),
+			},
+			{
+				Name:        "queued_resource",
+				Description: "The name of the queued resource that requested the TPU node, if any.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("QueuedResource").NullIfZero(),
+			},
+			{
+				Name:        "multislice_node",
+				Description: "True if the TPU node is part of a multislice.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "service_account_email",
+				Description: "The email address of the service account used by the TPU node.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ServiceAccount.Email"),
+			},
+			{
+				Name:        "self_link",
+				Description: "The server-defined URL for the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(tpuNodeTurbotData, "SelfLink"),
+			},
+			{
+				Name:        "data_disks",
+				Description: "The additional data disks attached to the TPU node.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "labels",
+				Description: "Resource labels to represent user-provided metadata.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "metadata",
+				Description: "Custom metadata to apply to the TPU node.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "network_config",
+				Description: "The network configuration of the TPU node, including the network, subnetwork and whether external IPs are enabled.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "network_endpoints",
+				Description: "The network endpoints where the TPU workers can be accessed and sent work.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "scheduling_config",
+				Description: "The scheduling options of the TPU node.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "service_account",
+				Description: "The Google Cloud Platform service account to be used by the TPU node, with its scopes.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "shielded_instance_config",
+				Description: "The Shielded VM configuration of the TPU node.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "symptoms",
+				Description: "The symptoms that have occurred to the TPU node.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "network_tags",
+				Description: "The network tags of the TPU node, used to identify valid sources or targets for network firewalls.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Tags"),
+			},
+
+			// standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(lastPathElement),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Labels"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromP(tpuNodeTurbotData, "Akas"),
+			},
+
+			// standard gcp columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(tpuNodeTurbotData, "Location"),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getProject,
+				Transform:   transform.FromValue(),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listTPUNodes(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var location string
+	matrixLocation := d.EqualsQualString(matrixKeyLocation)
+	// Since, when the service API is disabled, matrixLocation value will be nil
+	if matrixLocation != "" {
+		location = matrixLocation
+	}
+
+	// Minimize the API call with given location
+	if d.EqualsQualString("location") != "" && d.EqualsQualString("location") != location {
+		return nil, nil
+	}
+
+	// Max limit is set as per documentation
+	pageSize := types.Int64(100)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	// Create service connection
+	service, err := TPUService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_tpu_node.listTPUNodes", "service_error", err)
+		return nil, err
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	parent := "projects/" + project + "/locations/" + location
+
+	resp := service.Projects.Locations.Nodes.List(parent).PageSize(*pageSize)
+	if err := resp.Pages(ctx, func(page *tpu.ListNodesResponse) error {
+		for _, item := range page.Nodes {
+			d.StreamListItem(ctx, item)
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				return nil
+			}
+		}
+		return nil
+	}); err != nil {
+		plugin.Logger(ctx).Error("gcp_tpu_node.listTPUNodes", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getTPUNode(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	matrixLocation := d.EqualsQualString(matrixKeyLocation)
+
+	name := d.EqualsQualString("name")
+
+	if name == "" {
+		return nil, nil
+	}
+
+	// Restrict the API call for other locations
+	if len(strings.Split(name, "/")) > 3 && strings.Split(name, "/")[3] != matrixLocation {
+		return nil, nil
+	}
+
+	// Create service connection
+	service, err := TPUService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_tpu_node.getTPUNode", "service_error", err)
+		return nil, err
+	}
+
+	resp, err := service.Projects.Locations.Nodes.Get(name).Do()
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_tpu_node.getTPUNode", "api_error", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func tpuNodeTurbotData(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	data := d.HydrateItem.(*tpu.Node)
+	param := d.Param.(string)
+
+	splitName := strings.Split(data.Name, "/")
+
+	turbotData := map[string]interface{}{
+		"Location": splitName[3],
+		"SelfLink": "https://tpu.googleapis.com/v2/" + data.Name,
+		"Akas":     []string{"gcp://tpu.googleapis.com/" + data.Name},
+	}
+
+	return turbotData[param], nil
+}
@@ -0,0 +1,236 @@
+package gcp
+
+import (
+	"context"
+	"strings"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	"google.golang.org/api/tpu/v2"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpTPUQueuedResource(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_tpu_queued_resource",
+		Description: "GCP Cloud TPU Queued Resource",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			Hydrate:    getTPUQueuedResource,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listTPUQueuedResources,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "location", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: BuildTPULocationList,
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the queued resource, in the format projects/{project}/locations/{zone}/queuedResources/{queued_resource_id}.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state",
+				Description: "The state of the queued resource request, e.g. CREATING, ACCEPTED, PROVISIONING, FAILED, ACTIVE or SUSPENDED.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("State.State"),
+			},
+			{
+				Name:        "state_initiator",
+				Description: "The initiator of the current state of the queued resource, e.g. USER or SERVICE.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("State.StateInitiator"),
+			},
+			{
+				Name:        "create_time",
+				Description: "The time when the queued resource was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "reservation_name",
+				Description: "The name of the reservation the queued resource is requested in, if any.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "spot",
+				Description: "True if the TPU nodes are requested as Spot VMs.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromP(tpuQueuedResourceTurbotData, "Spot"),
+			},
+			{
+				Name:        "guaranteed_min_duration",
+				Description: "The minimum duration the TPU nodes are guaranteed to run for, if the queued resource requests guaranteed capacity.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Guaranteed.MinDuration"),
+			},
+			{
+				Name:        "self_link",
+				Description: "The server-defined URL for the queued resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(tpuQueuedResourceTurbotData, "SelfLink"),
+			},
+			{
+				Name:        "queueing_policy",
+				Description: "The time constraints of the queued resource request, e.g. the times after and until which the request is valid.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "node_specs",
+				Description: "The specifications of the TPU nodes requested by the queued resource.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Tpu.NodeSpec"),
+			},
+			{
+				Name:        "state_data",
+				Description: "Further information about the current state of the queued resource, e.g. the error of a failed request.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("State"),
+			},
+
+			// standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(lastPathElement),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromP(tpuQueuedResourceTurbotData, "Akas"),
+			},
+
+			// standard gcp columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(tpuQueuedResourceTurbotData, "Location"),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getProject,
+				Transform:   transform.FromValue(),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listTPUQueuedResources(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var location string
+	matrixLocation := d.EqualsQualString(matrixKeyLocation)
+	// Since, when the service API is disabled, matrixLocation value will be nil
+	if matrixLocation != "" {
+		location = matrixLocation
+	}
+
+	// Minimize the API call with given location
+	if d.EqualsQualString("location") != "" && d.EqualsQualString("location") != location {
+		return nil, nil
+	}
+
+	// Max limit is set as per documentation
+	pageSize := types.Int64(100)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	// Create service connection
+	service, err := TPUService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_tpu_queued_resource.listTPUQueuedResources", "service_error", err)
+		return nil, err
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	parent := "projects/" + project + "/locations/" + location
+
+	resp := service.Projects.Locations.QueuedResources.List(parent).PageSize(*pageSize)
+	if err := resp.Pages(ctx, func(page *tpu.ListQueuedResourcesResponse) error {
+		for _, item := range page.QueuedResources {
+			d.StreamListItem(ctx, item)
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				return nil
+			}
+		}
+		return nil
+	}); err != nil {
+		plugin.Logger(ctx).Error("gcp_tpu_queued_resource.listTPUQueuedResources", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getTPUQueuedResource(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	matrixLocation := d.EqualsQualString(matrixKeyLocation)
+
+	name := d.EqualsQualString("name")
+
+	if name == "" {
+		return nil, nil
+	}
+
+	// Restrict the API call for other locations
+	if len(strings.Split(name, "/")) > 3 && strings.Split(name, "/")[3] != matrixLocation {
+		return nil, nil
+	}
+
+	// Create service connection
+	service, err := TPUService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_tpu_queued_resource.getTPUQueuedResource", "service_error", err)
+		return nil, err
+	}
+
+	resp, err := service.Projects.Locations.QueuedResources.Get(name).Do()
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_tpu_queued_resource.getTPUQueuedResource", "api_error", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func tpuQueuedResourceTurbotData(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	data := d.HydrateItem.(*tpu.QueuedResource)
+	param := d.Param.(string)
+
+	splitName := strings.Split(data.Name, "/")
+
+	turbotData := map[string]interface{}{
+		"Location": splitName[3],
+		"Spot":     data.Spot != nil,
+		"SelfLink": "https://tpu.googleapis.com/v2/" + data.Name,
+		"Akas":     []string{"gcp://tpu.googleapis.com/" + data.Name},
+	}
+
+	return turbotData[param], nil
+}
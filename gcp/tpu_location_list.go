@@ -0,0 +1,47 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"google.golang.org/api/tpu/v2"
+)
+
+// BuildTPULocationList :: return a list of matrix items, one per location specified
+func BuildTPULocationList(ctx context.Context, d *plugin.QueryData) []map[string]interface{} {
+
+	// have we already created and cached the locations?
+	locationCacheKey := "BuildTPULocationList"
+	if cachedData, ok := d.ConnectionManager.Cache.Get(locationCacheKey); ok {
+		plugin.Logger(ctx).Debug("BuildTPULocationList:", cachedData.([]map[string]interface{}))
+		return cachedData.([]map[string]interface{})
+	}
+
+	// Create Service Connection
+	service, err := TPUService(ctx, d)
+	if err != nil {
+		return nil
+	}
+
+	// Get project details
+	projectData, err := activeProject(ctx, d)
+	if err != nil {
+		return nil
+	}
+	project := projectData.Project
+
+	resp := service.Projects.Locations.List("projects/" + project)
+
+	var matrix []map[string]interface{}
+	if err := resp.Pages(ctx, func(page *tpu.ListLocationsResponse) error {
+		for _, location := range page.Locations {
+			matrix = append(matrix, map[string]interface{}{matrixKeyLocation: location.LocationId})
+		}
+		return nil
+	}); err != nil {
+		return nil
+	}
+
+	d.ConnectionManager.Cache.Set(locationCacheKey, matrix)
+	return matrix
+}
@@ -8,6 +8,7 @@ import (
 	"os"
 	"os/exec"
 	"runtime"
+	"strconv"
 	"strings"
 	"unicode/utf8"
 
@@ -69,6 +70,44 @@ func getProject(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData)
 	return projectId, nil
 }
 
+// getProjectNumber returns the project number of the active project, which
+// some APIs, such as Service Networking, require instead of the project ID
+func getProjectNumber(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (any, error) {
+	projectNumber, err := getProjectNumberMemoized(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+
+	return projectNumber, nil
+}
+
+var getProjectNumberMemoized = plugin.HydrateFunc(getProjectNumberUncached).Memoize(memoize.WithCacheKeyFunction(getProjectNumberCacheKey))
+
+// Build a cache key for the call to getProjectNumber.
+func getProjectNumberCacheKey(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	return "getGCPProjectNumber", nil
+}
+
+func getProjectNumberUncached(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	service, err := CloudResourceManagerService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := service.Projects.Get(project).Do()
+	if err != nil {
+		return nil, err
+	}
+
+	return strconv.FormatInt(resp.ProjectNumber, 10), nil
+}
+
 func getProjectUncached(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
 	var err error
 	var projectData *projectInfo